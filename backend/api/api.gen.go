@@ -24,53 +24,299 @@ import (
 )
 
 const (
+	AdminAuthScopes  = "AdminAuth.Scopes"
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for ChatMessageCode.
+const (
+	BadPayload           ChatMessageCode = "bad_payload"
+	ExtensionLimit       ChatMessageCode = "extension_limit"
+	MessageDropped       ChatMessageCode = "message_dropped"
+	MessageTooLarge      ChatMessageCode = "message_too_large"
+	NotInConversation    ChatMessageCode = "not_in_conversation"
+	RateLimited          ChatMessageCode = "rate_limited"
+	RegistrationRequired ChatMessageCode = "registration_required"
+)
+
+// Defines values for ChatMessageReason.
+const (
+	ReasonModeratorAction     ChatMessageReason = "moderator_action"
+	ReasonPartnerDisconnected ChatMessageReason = "partner_disconnected"
+	ReasonPartnerSkipped      ChatMessageReason = "partner_skipped"
+	ReasonServerShutdown      ChatMessageReason = "server_shutdown"
+	ReasonTimeUp              ChatMessageReason = "time_up"
+)
+
 // Defines values for ChatMessageType.
 const (
-	Chat   ChatMessageType = "chat"
-	Paired ChatMessageType = "paired"
-	TimeUp ChatMessageType = "time_up"
+	Ack               ChatMessageType = "ack"
+	Away              ChatMessageType = "away"
+	Back              ChatMessageType = "back"
+	Chat              ChatMessageType = "chat"
+	Connect           ChatMessageType = "connect"
+	ContactAdded      ChatMessageType = "contact_added"
+	ConversationEnded ChatMessageType = "conversation_ended"
+	Delivered         ChatMessageType = "delivered"
+	ErrorEvent        ChatMessageType = "error"
+	ExtendAccept      ChatMessageType = "extend_accept"
+	ExtendRequest     ChatMessageType = "extend_request"
+	Extended          ChatMessageType = "extended"
+	MemberLeft        ChatMessageType = "member_left"
+	Notice            ChatMessageType = "notice"
+	Paired            ChatMessageType = "paired"
+	Queued            ChatMessageType = "queued"
+	Read              ChatMessageType = "read"
+	Resumed           ChatMessageType = "resumed"
+	ServerRestarting  ChatMessageType = "server_restarting"
+	StoppedTyping     ChatMessageType = "stopped_typing"
+	TimeUp            ChatMessageType = "time_up"
+	Typing            ChatMessageType = "typing"
+)
+
+// Defines values for ReportRequestReason.
+const (
+	Harassment    ReportRequestReason = "harassment"
+	Inappropriate ReportRequestReason = "inappropriate"
+	Other         ReportRequestReason = "other"
+	Spam          ReportRequestReason = "spam"
+	Underage      ReportRequestReason = "underage"
 )
 
+// AdminConversation defines model for AdminConversation.
+type AdminConversation struct {
+	ExpiresAt        time.Time `json:"expiresAt"`
+	Extensions       int       `json:"extensions"`
+	Id               string    `json:"id"`
+	Members          []string  `json:"members"`
+	RemainingSeconds int       `json:"remainingSeconds"`
+}
+
+// AdminQueueEntry defines model for AdminQueueEntry.
+type AdminQueueEntry struct {
+	Connected      bool      `json:"connected"`
+	Id             string    `json:"id"`
+	Interests      *[]string `json:"interests,omitempty"`
+	Language       *string   `json:"language,omitempty"`
+	Position       int       `json:"position"`
+	WaitingSeconds int       `json:"waitingSeconds"`
+}
+
+// AdminUser defines model for AdminUser.
+type AdminUser struct {
+	// ConversationId The round the user is in, if any.
+	ConversationId *string `json:"conversationId,omitempty"`
+	Id             string  `json:"id"`
+	Queued         bool    `json:"queued"`
+	Registered     bool    `json:"registered"`
+	Username       *string `json:"username,omitempty"`
+}
+
+// AnonymousSessionRequest defines model for AnonymousSessionRequest.
+type AnonymousSessionRequest struct {
+	// Interests Interest tags, e.g. `music`. Case and surrounding spaces are ignored.
+	Interests *[]string `json:"interests,omitempty"`
+
+	// Language Preferred chat language as a language tag, e.g. `en` or `pt-BR`.
+	Language *string `json:"language,omitempty"`
+}
+
 // AnonymousSessionResponse defines model for AnonymousSessionResponse.
 type AnonymousSessionResponse struct {
-	ConversationId   *string `json:"conversationId,omitempty"`
-	ExpiresInSeconds int32   `json:"expiresInSeconds"`
-	Token            string  `json:"token"`
-	WebsocketUrl     string  `json:"websocketUrl"`
+	ConversationId *string `json:"conversationId,omitempty"`
+
+	// ExpiresInSeconds Lifetime of `token`; use `refreshToken` before it runs out
+	ExpiresInSeconds int32  `json:"expiresInSeconds"`
+	RefreshToken     string `json:"refreshToken"`
+	Token            string `json:"token"`
+	WebsocketUrl     string `json:"websocketUrl"`
 }
 
 // AuthResponse defines model for AuthResponse.
 type AuthResponse struct {
-	Token string `json:"token"`
+	// ExpiresInSeconds Lifetime of `token`
+	ExpiresInSeconds int32  `json:"expiresInSeconds"`
+	RefreshToken     string `json:"refreshToken"`
+	Token            string `json:"token"`
+}
+
+// BlockRequest defines model for BlockRequest.
+type BlockRequest struct {
+	// UserId The user to block (see `members` / `from` on chat events).
+	UserId *string `json:"userId,omitempty"`
+}
+
+// BroadcastRequest defines model for BroadcastRequest.
+type BroadcastRequest struct {
+	Message string `json:"message"`
+}
+
+// BroadcastResult defines model for BroadcastResult.
+type BroadcastResult struct {
+	// Recipients Connected users the notice was queued for.
+	Recipients int `json:"recipients"`
+}
+
+// ChangePasswordRequest defines model for ChangePasswordRequest.
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"currentPassword"`
+	NewPassword     string `json:"newPassword"`
 }
 
 // ChatMessage A single envelope for every WebSocket event.
 //
-// • **chat**   → `message` + `timestamp` are present
-// • **paired** → `expiresAt` is present (when the round ends)
-// • **time_up**→ `timestamp` is present (when the round actually ends)
+// A round has two or more members (see `chat.groupSize`). Events that
+// a member sent, and `member_left`, carry that member's user ID in
+// `from`.
+//
+//   - **chat**   → `message` + `timestamp` + `seq` + `id` are present.
+//     Clients may set `clientMsgId`; a resend with the same value is not
+//     relayed again, the original `ack` is repeated instead. Moderation
+//     may reword or mask `message` before it is relayed; the sender's
+//     own copy shows what the others got.
+//   - **ack** → to the sender of a `chat`: the server `id`, `timestamp`
+//     and `seq`, plus the sender's `clientMsgId`.
+//   - **delivered** → to the sender once a member's connection has
+//     accepted the message, once per member; `id` names it, `from` is
+//     that member, `timestamp` is when.
+//   - **read** → optional, sent by a client with the `id` of a message it
+//     has displayed; relayed to the partner only.
+//   - **queued** → to a waiting user: `queue` holds their position, how
+//     many are online and the estimated wait. Sent on joining the queue
+//     and whenever those change noticeably.
+//   - **extend_request** / **extend_accept** → sent by a client to vote
+//     for more time; relayed to the partner so they can accept. Once
+//     everyone has voted the round is extended.
+//   - **extended** → the round was extended; `expiresAt` is the new end.
+//   - **connect** → sent by a registered client to keep in touch; relayed
+//     to the partner. When both have sent it, each gets
+//     **contact_added** with the other's `contact` (username revealed)
+//     and GET /contacts lists them. Anonymous senders get an `error`
+//     with code `registration_required`.
+//   - **member_left** → to the rest of a round that goes on without one
+//     member; `from` is who left and `reason` why. A round ends once
+//     fewer than two members remain.
+//   - **conversation_ended** → the conversation is over; `reason` says
+//     why. Stop the countdown. Everyone left in it is back in the queue,
+//     except on `server_shutdown`.
+//   - **error** → to one client whose frame was rejected; `code` says
+//     why and `message` may add detail. The frame had no effect and the
+//     connection stays open.
+//   - **paired** → `expiresAt` is present (when the round ends),
+//     `members` lists everyone in the round and `sharedInterests` the
+//     interests they all have in common
+//   - **time_up**→ `timestamp` is present (when the round actually ends)
+//   - **notice** → a system notice from the operators; `message` holds
+//     the text and `conversationId` is empty.
+//   - **server_restarting** → server is shutting down; a 1012 close frame
+//     follows. `conversationId` is empty. Reconnect with backoff.
+//   - **resumed** → sent on reconnect when the user is still in a live
+//     conversation; `expiresAt` is the time left and `members` who is
+//     still there. Missed `chat` messages
+//     follow, in `seq` order.
+//   - **typing** / **stopped_typing** / **away** / **back** → ephemeral
+//     presence signals. Sent by a client, relayed only to the partner,
+//     never stored or replayed. Rate‑limited; each excess signal is
+//     dropped and answered with an `error` with code `rate_limited`.
 //
-// All other combinations are ignored by the server.
+// Clients may only send `chat`, `typing`, `stopped_typing`, `away`,
+// `back`, `read`, `extend_request`, `extend_accept` and `connect`.
+// Any other type, or a frame that is not a valid ChatMessage, is
+// answered with an `error` with code `bad_payload`.
 type ChatMessage struct {
-	ConversationId string          `json:"conversationId"`
-	ExpiresAt      *time.Time      `json:"expiresAt"`
-	Message        *string         `json:"message"`
-	Timestamp      *time.Time      `json:"timestamp,omitempty"`
-	Type           ChatMessageType `json:"type"`
+	// ClientMsgId Client‑chosen ID of a `chat`, echoed in its `ack` and `delivered`.
+	ClientMsgId *string `json:"clientMsgId,omitempty"`
+
+	// Code Machine‑readable reason of an `error` event:
+	// • `not_in_conversation` – conversationId is not the sender's
+	//   current conversation
+	// • `rate_limited` – too many events of this kind; slow down
+	// • `message_too_large` – `message` is longer than the server allows
+	// • `bad_payload` – not valid JSON, not a ChatMessage, or a type
+	//   clients may not send
+	// • `extension_limit` – this round cannot be extended any further
+	// • `registration_required` – register an account first (`connect`)
+	// • `message_dropped` – moderation dropped the `chat` named by
+	//   `clientMsgId`; `message` says why
+	Code *ChatMessageCode `json:"code,omitempty"`
+
+	// Contact Public view of an account
+	Contact        *User      `json:"contact,omitempty"`
+	ConversationId string     `json:"conversationId"`
+	ExpiresAt      *time.Time `json:"expiresAt"`
+
+	// From User ID of the member who sent the event or, for `member_left`, who left; for `delivered`, who received the message.
+	From *string `json:"from,omitempty"`
+
+	// Id Server‑assigned message ID (`chat`, `ack`, `delivered`, `read`).
+	Id *string `json:"id,omitempty"`
+
+	// Members User IDs of everyone in the round (`paired`, `resumed`).
+	Members *[]string    `json:"members,omitempty"`
+	Message *string      `json:"message"`
+	Queue   *QueueStatus `json:"queue,omitempty"`
+
+	// Reason Why a `conversation_ended` or `member_left` event was sent.
+	Reason *ChatMessageReason `json:"reason,omitempty"`
+
+	// Seq Per‑conversation sequence number of a `chat` message.
+	Seq *int64 `json:"seq,omitempty"`
+
+	// SharedInterests Interests every member of the round gave (`paired`); may be empty.
+	SharedInterests *[]string       `json:"sharedInterests,omitempty"`
+	Timestamp       *time.Time      `json:"timestamp,omitempty"`
+	Type            ChatMessageType `json:"type"`
 }
 
+// ChatMessageCode Machine‑readable reason of an `error` event:
+//   - `not_in_conversation` – conversationId is not the sender's
+//     current conversation
+//   - `rate_limited` – too many events of this kind; slow down
+//   - `message_too_large` – `message` is longer than the server allows
+//   - `bad_payload` – not valid JSON, not a ChatMessage, or a type
+//     clients may not send
+//   - `extension_limit` – this round cannot be extended any further
+//   - `registration_required` – register an account first (`connect`)
+//   - `message_dropped` – moderation dropped the `chat` named by
+//     `clientMsgId`; `message` says why
+type ChatMessageCode string
+
+// ChatMessageReason Why a `conversation_ended` or `member_left` event was sent.
+type ChatMessageReason string
+
 // ChatMessageType defines model for ChatMessage.Type.
 type ChatMessageType string
 
+// Contact defines model for Contact.
+type Contact struct {
+	Id       string    `json:"id"`
+	Since    time.Time `json:"since"`
+	Username string    `json:"username"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	Details *string `json:"details"`
 	Error   string  `json:"error"`
 }
 
+// JWK RFC 7517 public key; `n`/`e` for RSA, `crv`/`x` for OKP
+type JWK struct {
+	Alg string  `json:"alg"`
+	Crv *string `json:"crv,omitempty"`
+	E   *string `json:"e,omitempty"`
+	Kid string  `json:"kid"`
+	Kty string  `json:"kty"`
+	N   *string `json:"n,omitempty"`
+	Use string  `json:"use"`
+	X   *string `json:"x,omitempty"`
+}
+
+// JWKS defines model for JWKS.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
 // LoginRequest defines model for LoginRequest.
 type LoginRequest struct {
 	Password string `json:"password"`
@@ -82,12 +328,45 @@ type Pong struct {
 	Ping string `json:"ping"`
 }
 
+// QueueStatus defines model for QueueStatus.
+type QueueStatus struct {
+	// EstimatedWaitSeconds Rough wait at `position`, from the recent pairing rate. Absent until enough pairs have been made to tell.
+	EstimatedWaitSeconds *int `json:"estimatedWaitSeconds,omitempty"`
+
+	// Online Users with an open chat socket.
+	Online int `json:"online"`
+
+	// Position 1‑based place in the queue; absent when not waiting.
+	Position *int `json:"position,omitempty"`
+
+	// Waiting Connected users waiting to be paired.
+	Waiting int `json:"waiting"`
+}
+
+// RefreshRequest defines model for RefreshRequest.
+type RefreshRequest struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
 // RegisterRequest defines model for RegisterRequest.
 type RegisterRequest struct {
-	Password *string `json:"password,omitempty"`
-	Username string  `json:"username"`
+	// Password 8–72 bytes, must differ from the username
+	Password string `json:"password"`
+	Username string `json:"username"`
 }
 
+// ReportRequest defines model for ReportRequest.
+type ReportRequest struct {
+	Note   *string             `json:"note,omitempty"`
+	Reason ReportRequestReason `json:"reason"`
+
+	// UserId The member being reported (see `members` / `from` on chat events).
+	UserId *string `json:"userId,omitempty"`
+}
+
+// ReportRequestReason defines model for ReportRequest.Reason.
+type ReportRequestReason string
+
 // User Public view of an account
 type User struct {
 	Id       string `json:"id"`
@@ -97,14 +376,49 @@ type User struct {
 // GetWsChatParams defines parameters for GetWsChat.
 type GetWsChatParams struct {
 	Token string `form:"token" json:"token"`
+
+	// LastSeq Highest chat `seq` the client has seen. On a reconnect within the
+	// resume grace window, chat messages after it are replayed. Without
+	// it the server replays what was sent since the socket dropped.
+	LastSeq *int64 `form:"lastSeq,omitempty" json:"lastSeq,omitempty"`
+
+	// Interests Interest tags for matching, as in AnonymousSessionRequest; repeat
+	// the parameter for several. This is how registered users, who join
+	// the queue here rather than through /session/anonymous, set them.
+	// Given, it replaces the user's interests; omitted, they are kept.
+	Interests *[]string `form:"interests,omitempty" json:"interests,omitempty"`
+
+	// Language Preferred chat language, as in AnonymousSessionRequest. Given, it replaces the user's language; omitted, it is kept.
+	Language *string `form:"language,omitempty" json:"language,omitempty"`
 }
 
+// PostAccountPasswordJSONRequestBody defines body for PostAccountPassword for application/json ContentType.
+type PostAccountPasswordJSONRequestBody = ChangePasswordRequest
+
 // PostAccountRegisterJSONRequestBody defines body for PostAccountRegister for application/json ContentType.
 type PostAccountRegisterJSONRequestBody = RegisterRequest
 
+// PostAdminBroadcastJSONRequestBody defines body for PostAdminBroadcast for application/json ContentType.
+type PostAdminBroadcastJSONRequestBody = BroadcastRequest
+
+// PostBlockJSONRequestBody defines body for PostBlock for application/json ContentType.
+type PostBlockJSONRequestBody = BlockRequest
+
+// PostConversationsIdReportJSONRequestBody defines body for PostConversationsIdReport for application/json ContentType.
+type PostConversationsIdReportJSONRequestBody = ReportRequest
+
 // PostLoginJSONRequestBody defines body for PostLogin for application/json ContentType.
 type PostLoginJSONRequestBody = LoginRequest
 
+// PostLogoutJSONRequestBody defines body for PostLogout for application/json ContentType.
+type PostLogoutJSONRequestBody = RefreshRequest
+
+// PostSessionAnonymousJSONRequestBody defines body for PostSessionAnonymous for application/json ContentType.
+type PostSessionAnonymousJSONRequestBody = AnonymousSessionRequest
+
+// PostSessionRefreshJSONRequestBody defines body for PostSessionRefresh for application/json ContentType.
+type PostSessionRefreshJSONRequestBody = RefreshRequest
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -178,24 +492,80 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetWellKnownJwksJson request
+	GetWellKnownJwksJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAccountPasswordWithBody request with any body
+	PostAccountPasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAccountPassword(ctx context.Context, body PostAccountPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostAccountRegisterWithBody request with any body
 	PostAccountRegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostAccountRegister(ctx context.Context, body PostAccountRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostAdminBroadcastWithBody request with any body
+	PostAdminBroadcastWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAdminBroadcast(ctx context.Context, body PostAdminBroadcastJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminConversations request
+	GetAdminConversations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAdminConversationsIdEnd request
+	PostAdminConversationsIdEnd(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminQueue request
+	GetAdminQueue(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminUsers request
+	GetAdminUsers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAdminUsersIdDisconnect request
+	PostAdminUsersIdDisconnect(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostBlockWithBody request with any body
+	PostBlockWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostBlock(ctx context.Context, body PostBlockJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetContacts request
+	GetContacts(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostConversationsIdReportWithBody request with any body
+	PostConversationsIdReportWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostConversationsIdReport(ctx context.Context, id string, body PostConversationsIdReportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostLoginWithBody request with any body
 	PostLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	PostLogin(ctx context.Context, body PostLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostLogoutWithBody request with any body
+	PostLogoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostLogout(ctx context.Context, body PostLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetMe request
 	GetMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetPing request
 	GetPing(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// PostSessionAnonymous request
-	PostSessionAnonymous(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// GetQueueStatus request
+	GetQueueStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSessionAnonymousWithBody request with any body
+	PostSessionAnonymousWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostSessionAnonymous(ctx context.Context, body PostSessionAnonymousJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSessionRefreshWithBody request with any body
+	PostSessionRefreshWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostSessionRefresh(ctx context.Context, body PostSessionRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// PostSessionSkip request
 	PostSessionSkip(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -204,8 +574,8 @@ type ClientInterface interface {
 	GetWsChat(ctx context.Context, params *GetWsChatParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) PostAccountRegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostAccountRegisterRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetWellKnownJwksJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWellKnownJwksJsonRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -216,8 +586,8 @@ func (c *Client) PostAccountRegisterWithBody(ctx context.Context, contentType st
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostAccountRegister(ctx context.Context, body PostAccountRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostAccountRegisterRequest(c.Server, body)
+func (c *Client) PostAccountPasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAccountPasswordRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -228,8 +598,8 @@ func (c *Client) PostAccountRegister(ctx context.Context, body PostAccountRegist
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostLoginRequestWithBody(c.Server, contentType, body)
+func (c *Client) PostAccountPassword(ctx context.Context, body PostAccountPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAccountPasswordRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -240,8 +610,8 @@ func (c *Client) PostLoginWithBody(ctx context.Context, contentType string, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostLogin(ctx context.Context, body PostLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostLoginRequest(c.Server, body)
+func (c *Client) PostAccountRegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAccountRegisterRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -252,8 +622,8 @@ func (c *Client) PostLogin(ctx context.Context, body PostLoginJSONRequestBody, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetMeRequest(c.Server)
+func (c *Client) PostAccountRegister(ctx context.Context, body PostAccountRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAccountRegisterRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -264,8 +634,8 @@ func (c *Client) GetMe(ctx context.Context, reqEditors ...RequestEditorFn) (*htt
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetPing(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetPingRequest(c.Server)
+func (c *Client) PostAdminBroadcastWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminBroadcastRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -276,8 +646,8 @@ func (c *Client) GetPing(ctx context.Context, reqEditors ...RequestEditorFn) (*h
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostSessionAnonymous(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostSessionAnonymousRequest(c.Server)
+func (c *Client) PostAdminBroadcast(ctx context.Context, body PostAdminBroadcastJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminBroadcastRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -288,8 +658,8 @@ func (c *Client) PostSessionAnonymous(ctx context.Context, reqEditors ...Request
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostSessionSkip(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostSessionSkipRequest(c.Server)
+func (c *Client) GetAdminConversations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminConversationsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -300,8 +670,8 @@ func (c *Client) PostSessionSkip(ctx context.Context, reqEditors ...RequestEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetWsChat(ctx context.Context, params *GetWsChatParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetWsChatRequest(c.Server, params)
+func (c *Client) PostAdminConversationsIdEnd(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminConversationsIdEndRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -312,196 +682,260 @@ func (c *Client) GetWsChat(ctx context.Context, params *GetWsChatParams, reqEdit
 	return c.Client.Do(req)
 }
 
-// NewPostAccountRegisterRequest calls the generic PostAccountRegister builder with application/json body
-func NewPostAccountRegisterRequest(server string, body PostAccountRegisterJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetAdminQueue(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminQueueRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostAccountRegisterRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostAccountRegisterRequestWithBody generates requests for PostAccountRegister with any type of body
-func NewPostAccountRegisterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetAdminUsers(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminUsersRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/account/register")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostAdminUsersIdDisconnect(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminUsersIdDisconnectRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostLoginRequest calls the generic PostLogin builder with application/json body
-func NewPostLoginRequest(server string, body PostLoginJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostBlockWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostBlockRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostLoginRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostLoginRequestWithBody generates requests for PostLogin with any type of body
-func NewPostLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostBlock(ctx context.Context, body PostBlockJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostBlockRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/login")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetContacts(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetContactsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetMeRequest generates requests for GetMe
-func NewGetMeRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostConversationsIdReportWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostConversationsIdReportRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/me")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostConversationsIdReport(ctx context.Context, id string, body PostConversationsIdReportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostConversationsIdReportRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetPingRequest generates requests for GetPing
-func NewGetPingRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLoginRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/ping")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostLogin(ctx context.Context, body PostLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLoginRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostSessionAnonymousRequest generates requests for PostSessionAnonymous
-func NewPostSessionAnonymousRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) PostLogoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLogoutRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/session/anonymous")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostLogout(ctx context.Context, body PostLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLogoutRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostSessionSkipRequest generates requests for PostSessionSkip
-func NewPostSessionSkipRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetMe(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMeRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/session/skip")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetPing(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPingRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+func (c *Client) GetQueueStatus(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetQueueStatusRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) PostSessionAnonymousWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionAnonymousRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetWsChatRequest generates requests for GetWsChat
-func NewGetWsChatRequest(server string, params *GetWsChatParams) (*http.Request, error) {
+func (c *Client) PostSessionAnonymous(ctx context.Context, body PostSessionAnonymousJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionAnonymousRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSessionRefreshWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionRefreshRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSessionRefresh(ctx context.Context, body PostSessionRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionRefreshRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostSessionSkip(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSessionSkipRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWsChat(ctx context.Context, params *GetWsChatParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWsChatRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetWellKnownJwksJsonRequest generates requests for GetWellKnownJwksJson
+func NewGetWellKnownJwksJsonRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -509,7 +943,7 @@ func NewGetWsChatRequest(server string, params *GetWsChatParams) (*http.Request,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/ws/chat")
+	operationPath := fmt.Sprintf("/.well-known/jwks.json")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -519,24 +953,6 @@ func NewGetWsChatRequest(server string, params *GetWsChatParams) (*http.Request,
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "token", runtime.ParamLocationQuery, params.Token); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -545,351 +961,2217 @@ func NewGetWsChatRequest(server string, params *GetWsChatParams) (*http.Request,
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewPostAccountPasswordRequest calls the generic PostAccountPassword builder with application/json body
+func NewPostAccountPasswordRequest(server string, body PostAccountPasswordJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAccountPasswordRequestWithBody(server, "application/json", bodyReader)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewPostAccountPasswordRequestWithBody generates requests for PostAccountPassword with any type of body
+func NewPostAccountPasswordRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	operationPath := fmt.Sprintf("/account/password")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// PostAccountRegisterWithBodyWithResponse request with any body
-	PostAccountRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAccountRegisterResponse, error)
-
-	PostAccountRegisterWithResponse(ctx context.Context, body PostAccountRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAccountRegisterResponse, error)
-
-	// PostLoginWithBodyWithResponse request with any body
-	PostLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLoginResponse, error)
 
-	PostLoginWithResponse(ctx context.Context, body PostLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLoginResponse, error)
-
-	// GetMeWithResponse request
-	GetMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMeResponse, error)
-
-	// GetPingWithResponse request
-	GetPingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPingResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostSessionAnonymousWithResponse request
-	PostSessionAnonymousWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostSessionAnonymousResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostSessionSkipWithResponse request
-	PostSessionSkipWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostSessionSkipResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// GetWsChatWithResponse request
-	GetWsChatWithResponse(ctx context.Context, params *GetWsChatParams, reqEditors ...RequestEditorFn) (*GetWsChatResponse, error)
+	return req, nil
 }
 
-type PostAccountRegisterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *AuthResponse
-	JSON409      *Error
+// NewPostAccountRegisterRequest calls the generic PostAccountRegister builder with application/json body
+func NewPostAccountRegisterRequest(server string, body PostAccountRegisterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAccountRegisterRequestWithBody(server, "application/json", bodyReader)
 }
 
-// Status returns HTTPResponse.Status
-func (r PostAccountRegisterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPostAccountRegisterRequestWithBody generates requests for PostAccountRegister with any type of body
+func NewPostAccountRegisterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostAccountRegisterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/account/register")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type PostLoginResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AuthResponse
-	JSON401      *Error
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r PostLoginResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostLoginResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostAdminBroadcastRequest calls the generic PostAdminBroadcast builder with application/json body
+func NewPostAdminBroadcastRequest(server string, body PostAdminBroadcastJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAdminBroadcastRequestWithBody(server, "application/json", bodyReader)
 }
 
-type GetMeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
-}
+// NewPostAdminBroadcastRequestWithBody generates requests for PostAdminBroadcast with any type of body
+func NewPostAdminBroadcastRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r GetMeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetMeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/admin/broadcast")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type GetPingResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Pong
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetPingResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetPingResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewGetAdminConversationsRequest generates requests for GetAdminConversations
+func NewGetAdminConversationsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type PostSessionAnonymousResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *AnonymousSessionResponse
-}
+	operationPath := fmt.Sprintf("/admin/conversations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r PostSessionAnonymousResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostSessionAnonymousResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	return req, nil
 }
 
-type PostSessionSkipResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON429      *Error
+// NewPostAdminConversationsIdEndRequest generates requests for PostAdminConversationsIdEnd
+func NewPostAdminConversationsIdEndRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/conversations/%s/end", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PostSessionSkipResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetAdminQueueRequest generates requests for GetAdminQueue
+func NewGetAdminQueueRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	operationPath := fmt.Sprintf("/admin/queue")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostSessionSkipResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewGetAdminUsersRequest generates requests for GetAdminUsers
+func NewGetAdminUsersRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	operationPath := fmt.Sprintf("/admin/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-type GetWsChatResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+// NewPostAdminUsersIdDisconnectRequest generates requests for PostAdminUsersIdDisconnect
+func NewPostAdminUsersIdDisconnectRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/users/%s/disconnect", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetWsChatResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPostBlockRequest calls the generic PostBlock builder with application/json body
+func NewPostBlockRequest(server string, body PostBlockJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostBlockRequestWithBody(server, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetWsChatResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPostBlockRequestWithBody generates requests for PostBlock with any type of body
+func NewPostBlockRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	operationPath := fmt.Sprintf("/block")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// PostAccountRegisterWithBodyWithResponse request with arbitrary body returning *PostAccountRegisterResponse
-func (c *ClientWithResponses) PostAccountRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAccountRegisterResponse, error) {
-	rsp, err := c.PostAccountRegisterWithBody(ctx, contentType, body, reqEditors...)
+// NewGetContactsRequest generates requests for GetContacts
+func NewGetContactsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostAccountRegisterResponse(rsp)
+
+	operationPath := fmt.Sprintf("/contacts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) PostAccountRegisterWithResponse(ctx context.Context, body PostAccountRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAccountRegisterResponse, error) {
-	rsp, err := c.PostAccountRegister(ctx, body, reqEditors...)
+// NewPostConversationsIdReportRequest calls the generic PostConversationsIdReport builder with application/json body
+func NewPostConversationsIdReportRequest(server string, id string, body PostConversationsIdReportJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostAccountRegisterResponse(rsp)
+	bodyReader = bytes.NewReader(buf)
+	return NewPostConversationsIdReportRequestWithBody(server, id, "application/json", bodyReader)
 }
 
-// PostLoginWithBodyWithResponse request with arbitrary body returning *PostLoginResponse
-func (c *ClientWithResponses) PostLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLoginResponse, error) {
-	rsp, err := c.PostLoginWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// NewPostConversationsIdReportRequestWithBody generates requests for PostConversationsIdReport with any type of body
+func NewPostConversationsIdReportRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/conversations/%s/report", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostLoginRequest calls the generic PostLogin builder with application/json body
+func NewPostLoginRequest(server string, body PostLoginJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostLoginRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostLoginRequestWithBody generates requests for PostLogin with any type of body
+func NewPostLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostLogoutRequest calls the generic PostLogout builder with application/json body
+func NewPostLogoutRequest(server string, body PostLogoutJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostLogoutRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostLogoutRequestWithBody generates requests for PostLogout with any type of body
+func NewPostLogoutRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/logout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetMeRequest generates requests for GetMe
+func NewGetMeRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPingRequest generates requests for GetPing
+func NewGetPingRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ping")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetQueueStatusRequest generates requests for GetQueueStatus
+func NewGetQueueStatusRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/queue/status")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostSessionAnonymousRequest calls the generic PostSessionAnonymous builder with application/json body
+func NewPostSessionAnonymousRequest(server string, body PostSessionAnonymousJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSessionAnonymousRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostSessionAnonymousRequestWithBody generates requests for PostSessionAnonymous with any type of body
+func NewPostSessionAnonymousRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/session/anonymous")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostSessionRefreshRequest calls the generic PostSessionRefresh builder with application/json body
+func NewPostSessionRefreshRequest(server string, body PostSessionRefreshJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostSessionRefreshRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostSessionRefreshRequestWithBody generates requests for PostSessionRefresh with any type of body
+func NewPostSessionRefreshRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/session/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostSessionSkipRequest generates requests for PostSessionSkip
+func NewPostSessionSkipRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/session/skip")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWsChatRequest generates requests for GetWsChat
+func NewGetWsChatRequest(server string, params *GetWsChatParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ws/chat")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "token", runtime.ParamLocationQuery, params.Token); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.LastSeq != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "lastSeq", runtime.ParamLocationQuery, *params.LastSeq); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Interests != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "interests", runtime.ParamLocationQuery, *params.Interests); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Language != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "language", runtime.ParamLocationQuery, *params.Language); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetWellKnownJwksJsonWithResponse request
+	GetWellKnownJwksJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWellKnownJwksJsonResponse, error)
+
+	// PostAccountPasswordWithBodyWithResponse request with any body
+	PostAccountPasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAccountPasswordResponse, error)
+
+	PostAccountPasswordWithResponse(ctx context.Context, body PostAccountPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAccountPasswordResponse, error)
+
+	// PostAccountRegisterWithBodyWithResponse request with any body
+	PostAccountRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAccountRegisterResponse, error)
+
+	PostAccountRegisterWithResponse(ctx context.Context, body PostAccountRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAccountRegisterResponse, error)
+
+	// PostAdminBroadcastWithBodyWithResponse request with any body
+	PostAdminBroadcastWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminBroadcastResponse, error)
+
+	PostAdminBroadcastWithResponse(ctx context.Context, body PostAdminBroadcastJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminBroadcastResponse, error)
+
+	// GetAdminConversationsWithResponse request
+	GetAdminConversationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAdminConversationsResponse, error)
+
+	// PostAdminConversationsIdEndWithResponse request
+	PostAdminConversationsIdEndWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostAdminConversationsIdEndResponse, error)
+
+	// GetAdminQueueWithResponse request
+	GetAdminQueueWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAdminQueueResponse, error)
+
+	// GetAdminUsersWithResponse request
+	GetAdminUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAdminUsersResponse, error)
+
+	// PostAdminUsersIdDisconnectWithResponse request
+	PostAdminUsersIdDisconnectWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostAdminUsersIdDisconnectResponse, error)
+
+	// PostBlockWithBodyWithResponse request with any body
+	PostBlockWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBlockResponse, error)
+
+	PostBlockWithResponse(ctx context.Context, body PostBlockJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBlockResponse, error)
+
+	// GetContactsWithResponse request
+	GetContactsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetContactsResponse, error)
+
+	// PostConversationsIdReportWithBodyWithResponse request with any body
+	PostConversationsIdReportWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostConversationsIdReportResponse, error)
+
+	PostConversationsIdReportWithResponse(ctx context.Context, id string, body PostConversationsIdReportJSONRequestBody, reqEditors ...RequestEditorFn) (*PostConversationsIdReportResponse, error)
+
+	// PostLoginWithBodyWithResponse request with any body
+	PostLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLoginResponse, error)
+
+	PostLoginWithResponse(ctx context.Context, body PostLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLoginResponse, error)
+
+	// PostLogoutWithBodyWithResponse request with any body
+	PostLogoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLogoutResponse, error)
+
+	PostLogoutWithResponse(ctx context.Context, body PostLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLogoutResponse, error)
+
+	// GetMeWithResponse request
+	GetMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMeResponse, error)
+
+	// GetPingWithResponse request
+	GetPingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPingResponse, error)
+
+	// GetQueueStatusWithResponse request
+	GetQueueStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetQueueStatusResponse, error)
+
+	// PostSessionAnonymousWithBodyWithResponse request with any body
+	PostSessionAnonymousWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionAnonymousResponse, error)
+
+	PostSessionAnonymousWithResponse(ctx context.Context, body PostSessionAnonymousJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionAnonymousResponse, error)
+
+	// PostSessionRefreshWithBodyWithResponse request with any body
+	PostSessionRefreshWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionRefreshResponse, error)
+
+	PostSessionRefreshWithResponse(ctx context.Context, body PostSessionRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionRefreshResponse, error)
+
+	// PostSessionSkipWithResponse request
+	PostSessionSkipWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostSessionSkipResponse, error)
+
+	// GetWsChatWithResponse request
+	GetWsChatWithResponse(ctx context.Context, params *GetWsChatParams, reqEditors ...RequestEditorFn) (*GetWsChatResponse, error)
+}
+
+type GetWellKnownJwksJsonResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JWKS
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWellKnownJwksJsonResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWellKnownJwksJsonResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAccountPasswordResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAccountPasswordResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAccountPasswordResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAccountRegisterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AuthResponse
+	JSON400      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAccountRegisterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAccountRegisterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminBroadcastResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BroadcastResult
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminBroadcastResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminBroadcastResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminConversationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AdminConversation
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminConversationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminConversationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminConversationsIdEndResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminConversationsIdEndResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminConversationsIdEndResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminQueueResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AdminQueueEntry
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminQueueResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminQueueResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AdminUser
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminUsersIdDisconnectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminUsersIdDisconnectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminUsersIdDisconnectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostBlockResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostBlockResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostBlockResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetContactsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Contact
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetContactsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetContactsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostConversationsIdReportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostConversationsIdReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostConversationsIdReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostLoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthResponse
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostLoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostLoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostLogoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostLogoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostLogoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Pong
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetQueueStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *QueueStatus
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetQueueStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetQueueStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSessionAnonymousResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AnonymousSessionResponse
+	JSON400      *Error
+	JSON503      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSessionAnonymousResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSessionAnonymousResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSessionRefreshResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthResponse
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSessionRefreshResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSessionRefreshResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSessionSkipResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON429      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSessionSkipResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSessionSkipResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWsChatResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWsChatResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWsChatResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetWellKnownJwksJsonWithResponse request returning *GetWellKnownJwksJsonResponse
+func (c *ClientWithResponses) GetWellKnownJwksJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWellKnownJwksJsonResponse, error) {
+	rsp, err := c.GetWellKnownJwksJson(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWellKnownJwksJsonResponse(rsp)
+}
+
+// PostAccountPasswordWithBodyWithResponse request with arbitrary body returning *PostAccountPasswordResponse
+func (c *ClientWithResponses) PostAccountPasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAccountPasswordResponse, error) {
+	rsp, err := c.PostAccountPasswordWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAccountPasswordResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAccountPasswordWithResponse(ctx context.Context, body PostAccountPasswordJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAccountPasswordResponse, error) {
+	rsp, err := c.PostAccountPassword(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAccountPasswordResponse(rsp)
+}
+
+// PostAccountRegisterWithBodyWithResponse request with arbitrary body returning *PostAccountRegisterResponse
+func (c *ClientWithResponses) PostAccountRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAccountRegisterResponse, error) {
+	rsp, err := c.PostAccountRegisterWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAccountRegisterResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAccountRegisterWithResponse(ctx context.Context, body PostAccountRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAccountRegisterResponse, error) {
+	rsp, err := c.PostAccountRegister(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAccountRegisterResponse(rsp)
+}
+
+// PostAdminBroadcastWithBodyWithResponse request with arbitrary body returning *PostAdminBroadcastResponse
+func (c *ClientWithResponses) PostAdminBroadcastWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminBroadcastResponse, error) {
+	rsp, err := c.PostAdminBroadcastWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminBroadcastResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAdminBroadcastWithResponse(ctx context.Context, body PostAdminBroadcastJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminBroadcastResponse, error) {
+	rsp, err := c.PostAdminBroadcast(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminBroadcastResponse(rsp)
+}
+
+// GetAdminConversationsWithResponse request returning *GetAdminConversationsResponse
+func (c *ClientWithResponses) GetAdminConversationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAdminConversationsResponse, error) {
+	rsp, err := c.GetAdminConversations(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminConversationsResponse(rsp)
+}
+
+// PostAdminConversationsIdEndWithResponse request returning *PostAdminConversationsIdEndResponse
+func (c *ClientWithResponses) PostAdminConversationsIdEndWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostAdminConversationsIdEndResponse, error) {
+	rsp, err := c.PostAdminConversationsIdEnd(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminConversationsIdEndResponse(rsp)
+}
+
+// GetAdminQueueWithResponse request returning *GetAdminQueueResponse
+func (c *ClientWithResponses) GetAdminQueueWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAdminQueueResponse, error) {
+	rsp, err := c.GetAdminQueue(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminQueueResponse(rsp)
+}
+
+// GetAdminUsersWithResponse request returning *GetAdminUsersResponse
+func (c *ClientWithResponses) GetAdminUsersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAdminUsersResponse, error) {
+	rsp, err := c.GetAdminUsers(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminUsersResponse(rsp)
+}
+
+// PostAdminUsersIdDisconnectWithResponse request returning *PostAdminUsersIdDisconnectResponse
+func (c *ClientWithResponses) PostAdminUsersIdDisconnectWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*PostAdminUsersIdDisconnectResponse, error) {
+	rsp, err := c.PostAdminUsersIdDisconnect(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminUsersIdDisconnectResponse(rsp)
+}
+
+// PostBlockWithBodyWithResponse request with arbitrary body returning *PostBlockResponse
+func (c *ClientWithResponses) PostBlockWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostBlockResponse, error) {
+	rsp, err := c.PostBlockWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBlockResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostBlockWithResponse(ctx context.Context, body PostBlockJSONRequestBody, reqEditors ...RequestEditorFn) (*PostBlockResponse, error) {
+	rsp, err := c.PostBlock(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostBlockResponse(rsp)
+}
+
+// GetContactsWithResponse request returning *GetContactsResponse
+func (c *ClientWithResponses) GetContactsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetContactsResponse, error) {
+	rsp, err := c.GetContacts(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetContactsResponse(rsp)
+}
+
+// PostConversationsIdReportWithBodyWithResponse request with arbitrary body returning *PostConversationsIdReportResponse
+func (c *ClientWithResponses) PostConversationsIdReportWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostConversationsIdReportResponse, error) {
+	rsp, err := c.PostConversationsIdReportWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostConversationsIdReportResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostConversationsIdReportWithResponse(ctx context.Context, id string, body PostConversationsIdReportJSONRequestBody, reqEditors ...RequestEditorFn) (*PostConversationsIdReportResponse, error) {
+	rsp, err := c.PostConversationsIdReport(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostConversationsIdReportResponse(rsp)
+}
+
+// PostLoginWithBodyWithResponse request with arbitrary body returning *PostLoginResponse
+func (c *ClientWithResponses) PostLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLoginResponse, error) {
+	rsp, err := c.PostLoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostLoginWithResponse(ctx context.Context, body PostLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLoginResponse, error) {
+	rsp, err := c.PostLogin(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLoginResponse(rsp)
+}
+
+// PostLogoutWithBodyWithResponse request with arbitrary body returning *PostLogoutResponse
+func (c *ClientWithResponses) PostLogoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLogoutResponse, error) {
+	rsp, err := c.PostLogoutWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLogoutResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostLogoutWithResponse(ctx context.Context, body PostLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLogoutResponse, error) {
+	rsp, err := c.PostLogout(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLogoutResponse(rsp)
+}
+
+// GetMeWithResponse request returning *GetMeResponse
+func (c *ClientWithResponses) GetMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMeResponse, error) {
+	rsp, err := c.GetMe(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMeResponse(rsp)
+}
+
+// GetPingWithResponse request returning *GetPingResponse
+func (c *ClientWithResponses) GetPingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPingResponse, error) {
+	rsp, err := c.GetPing(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPingResponse(rsp)
+}
+
+// GetQueueStatusWithResponse request returning *GetQueueStatusResponse
+func (c *ClientWithResponses) GetQueueStatusWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetQueueStatusResponse, error) {
+	rsp, err := c.GetQueueStatus(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetQueueStatusResponse(rsp)
+}
+
+// PostSessionAnonymousWithBodyWithResponse request with arbitrary body returning *PostSessionAnonymousResponse
+func (c *ClientWithResponses) PostSessionAnonymousWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionAnonymousResponse, error) {
+	rsp, err := c.PostSessionAnonymousWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionAnonymousResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostSessionAnonymousWithResponse(ctx context.Context, body PostSessionAnonymousJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionAnonymousResponse, error) {
+	rsp, err := c.PostSessionAnonymous(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionAnonymousResponse(rsp)
+}
+
+// PostSessionRefreshWithBodyWithResponse request with arbitrary body returning *PostSessionRefreshResponse
+func (c *ClientWithResponses) PostSessionRefreshWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSessionRefreshResponse, error) {
+	rsp, err := c.PostSessionRefreshWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionRefreshResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostSessionRefreshWithResponse(ctx context.Context, body PostSessionRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostSessionRefreshResponse, error) {
+	rsp, err := c.PostSessionRefresh(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionRefreshResponse(rsp)
+}
+
+// PostSessionSkipWithResponse request returning *PostSessionSkipResponse
+func (c *ClientWithResponses) PostSessionSkipWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostSessionSkipResponse, error) {
+	rsp, err := c.PostSessionSkip(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSessionSkipResponse(rsp)
+}
+
+// GetWsChatWithResponse request returning *GetWsChatResponse
+func (c *ClientWithResponses) GetWsChatWithResponse(ctx context.Context, params *GetWsChatParams, reqEditors ...RequestEditorFn) (*GetWsChatResponse, error) {
+	rsp, err := c.GetWsChat(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWsChatResponse(rsp)
+}
+
+// ParseGetWellKnownJwksJsonResponse parses an HTTP response from a GetWellKnownJwksJsonWithResponse call
+func ParseGetWellKnownJwksJsonResponse(rsp *http.Response) (*GetWellKnownJwksJsonResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWellKnownJwksJsonResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JWKS
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAccountPasswordResponse parses an HTTP response from a PostAccountPasswordWithResponse call
+func ParsePostAccountPasswordResponse(rsp *http.Response) (*PostAccountPasswordResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAccountPasswordResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAccountRegisterResponse parses an HTTP response from a PostAccountRegisterWithResponse call
+func ParsePostAccountRegisterResponse(rsp *http.Response) (*PostAccountRegisterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAccountRegisterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AuthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminBroadcastResponse parses an HTTP response from a PostAdminBroadcastWithResponse call
+func ParsePostAdminBroadcastResponse(rsp *http.Response) (*PostAdminBroadcastResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminBroadcastResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BroadcastResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminConversationsResponse parses an HTTP response from a GetAdminConversationsWithResponse call
+func ParseGetAdminConversationsResponse(rsp *http.Response) (*GetAdminConversationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminConversationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AdminConversation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminConversationsIdEndResponse parses an HTTP response from a PostAdminConversationsIdEndWithResponse call
+func ParsePostAdminConversationsIdEndResponse(rsp *http.Response) (*PostAdminConversationsIdEndResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminConversationsIdEndResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminQueueResponse parses an HTTP response from a GetAdminQueueWithResponse call
+func ParseGetAdminQueueResponse(rsp *http.Response) (*GetAdminQueueResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminQueueResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AdminQueueEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminUsersResponse parses an HTTP response from a GetAdminUsersWithResponse call
+func ParseGetAdminUsersResponse(rsp *http.Response) (*GetAdminUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AdminUser
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminUsersIdDisconnectResponse parses an HTTP response from a PostAdminUsersIdDisconnectWithResponse call
+func ParsePostAdminUsersIdDisconnectResponse(rsp *http.Response) (*PostAdminUsersIdDisconnectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminUsersIdDisconnectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostBlockResponse parses an HTTP response from a PostBlockWithResponse call
+func ParsePostBlockResponse(rsp *http.Response) (*PostBlockResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostBlockResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetContactsResponse parses an HTTP response from a GetContactsWithResponse call
+func ParseGetContactsResponse(rsp *http.Response) (*GetContactsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetContactsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Contact
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostConversationsIdReportResponse parses an HTTP response from a PostConversationsIdReportWithResponse call
+func ParsePostConversationsIdReportResponse(rsp *http.Response) (*PostConversationsIdReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostConversationsIdReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostLoginResponse parses an HTTP response from a PostLoginWithResponse call
+func ParsePostLoginResponse(rsp *http.Response) (*PostLoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostLoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostLogoutResponse parses an HTTP response from a PostLogoutWithResponse call
+func ParsePostLogoutResponse(rsp *http.Response) (*PostLogoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostLogoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetMeResponse parses an HTTP response from a GetMeWithResponse call
+func ParseGetMeResponse(rsp *http.Response) (*GetMeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParsePostLoginResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PostLoginWithResponse(ctx context.Context, body PostLoginJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLoginResponse, error) {
-	rsp, err := c.PostLogin(ctx, body, reqEditors...)
+// ParseGetPingResponse parses an HTTP response from a GetPingWithResponse call
+func ParseGetPingResponse(rsp *http.Response) (*GetPingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostLoginResponse(rsp)
-}
 
-// GetMeWithResponse request returning *GetMeResponse
-func (c *ClientWithResponses) GetMeWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMeResponse, error) {
-	rsp, err := c.GetMe(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetPingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetMeResponse(rsp)
-}
 
-// GetPingWithResponse request returning *GetPingResponse
-func (c *ClientWithResponses) GetPingWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPingResponse, error) {
-	rsp, err := c.GetPing(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Pong
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseGetPingResponse(rsp)
+
+	return response, nil
 }
 
-// PostSessionAnonymousWithResponse request returning *PostSessionAnonymousResponse
-func (c *ClientWithResponses) PostSessionAnonymousWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostSessionAnonymousResponse, error) {
-	rsp, err := c.PostSessionAnonymous(ctx, reqEditors...)
+// ParseGetQueueStatusResponse parses an HTTP response from a GetQueueStatusWithResponse call
+func ParseGetQueueStatusResponse(rsp *http.Response) (*GetQueueStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostSessionAnonymousResponse(rsp)
-}
 
-// PostSessionSkipWithResponse request returning *PostSessionSkipResponse
-func (c *ClientWithResponses) PostSessionSkipWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostSessionSkipResponse, error) {
-	rsp, err := c.PostSessionSkip(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetQueueStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostSessionSkipResponse(rsp)
-}
 
-// GetWsChatWithResponse request returning *GetWsChatResponse
-func (c *ClientWithResponses) GetWsChatWithResponse(ctx context.Context, params *GetWsChatParams, reqEditors ...RequestEditorFn) (*GetWsChatResponse, error) {
-	rsp, err := c.GetWsChat(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest QueueStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
 	}
-	return ParseGetWsChatResponse(rsp)
+
+	return response, nil
 }
 
-// ParsePostAccountRegisterResponse parses an HTTP response from a PostAccountRegisterWithResponse call
-func ParsePostAccountRegisterResponse(rsp *http.Response) (*PostAccountRegisterResponse, error) {
+// ParsePostSessionAnonymousResponse parses an HTTP response from a PostSessionAnonymousWithResponse call
+func ParsePostSessionAnonymousResponse(rsp *http.Response) (*PostSessionAnonymousResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostAccountRegisterResponse{
+	response := &PostSessionAnonymousResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest AuthResponse
+		var dest AnonymousSessionResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON201 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParsePostLoginResponse parses an HTTP response from a PostLoginWithResponse call
-func ParsePostLoginResponse(rsp *http.Response) (*PostLoginResponse, error) {
+// ParsePostSessionRefreshResponse parses an HTTP response from a PostSessionRefreshWithResponse call
+func ParsePostSessionRefreshResponse(rsp *http.Response) (*PostSessionRefreshResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostLoginResponse{
+	response := &PostSessionRefreshResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -911,168 +3193,389 @@ func ParsePostLoginResponse(rsp *http.Response) (*PostLoginResponse, error) {
 
 	}
 
-	return response, nil
+	return response, nil
+}
+
+// ParsePostSessionSkipResponse parses an HTTP response from a PostSessionSkipWithResponse call
+func ParsePostSessionSkipResponse(rsp *http.Response) (*PostSessionSkipResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSessionSkipResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWsChatResponse parses an HTTP response from a GetWsChatWithResponse call
+func ParseGetWsChatResponse(rsp *http.Response) (*GetWsChatResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWsChatResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Public keys that verify access tokens (RS256 / EdDSA only)
+	// (GET /.well-known/jwks.json)
+	GetWellKnownJwksJson(w http.ResponseWriter, r *http.Request)
+	// Change the password of the current account
+	// (POST /account/password)
+	PostAccountPassword(w http.ResponseWriter, r *http.Request)
+	// Create a persistent account (username must be unique)
+	// (POST /account/register)
+	PostAccountRegister(w http.ResponseWriter, r *http.Request)
+	// Send a system notice to every connected user
+	// (POST /admin/broadcast)
+	PostAdminBroadcast(w http.ResponseWriter, r *http.Request)
+	// Active conversations with their remaining time
+	// (GET /admin/conversations)
+	GetAdminConversations(w http.ResponseWriter, r *http.Request)
+	// End a conversation now
+	// (POST /admin/conversations/{id}/end)
+	PostAdminConversationsIdEnd(w http.ResponseWriter, r *http.Request, id string)
+	// The waiting queue, front first
+	// (GET /admin/queue)
+	GetAdminQueue(w http.ResponseWriter, r *http.Request)
+	// Users with an open chat socket
+	// (GET /admin/users)
+	GetAdminUsers(w http.ResponseWriter, r *http.Request)
+	// Close a user's socket and take them out of their round
+	// (POST /admin/users/{id}/disconnect)
+	PostAdminUsersIdDisconnect(w http.ResponseWriter, r *http.Request, id string)
+	// Never be paired with a user again
+	// (POST /block)
+	PostBlock(w http.ResponseWriter, r *http.Request)
+	// People the caller connected with, oldest first
+	// (GET /contacts)
+	GetContacts(w http.ResponseWriter, r *http.Request)
+	// Report a member of the caller's current or latest round
+	// (POST /conversations/{id}/report)
+	PostConversationsIdReport(w http.ResponseWriter, r *http.Request, id string)
+	// Log in with an existing account
+	// (POST /login)
+	PostLogin(w http.ResponseWriter, r *http.Request)
+	// Revoke a refresh token family (and the presented access token)
+	// (POST /logout)
+	PostLogout(w http.ResponseWriter, r *http.Request)
+	// Return the current user profile
+	// (GET /me)
+	GetMe(w http.ResponseWriter, r *http.Request)
+
+	// (GET /ping)
+	GetPing(w http.ResponseWriter, r *http.Request)
+	// Current queue length, people online and estimated wait
+	// (GET /queue/status)
+	GetQueueStatus(w http.ResponseWriter, r *http.Request)
+	// join the waiting queue
+	// (POST /session/anonymous)
+	PostSessionAnonymous(w http.ResponseWriter, r *http.Request)
+	// Exchange a refresh token for a new access + refresh token pair
+	// (POST /session/refresh)
+	PostSessionRefresh(w http.ResponseWriter, r *http.Request)
+	// Leave the current conversation and rotate immediately
+	// (POST /session/skip)
+	PostSessionSkip(w http.ResponseWriter, r *http.Request)
+	// WebSocket for real‑time chat
+	// (GET /ws/chat)
+	GetWsChat(w http.ResponseWriter, r *http.Request, params GetWsChatParams)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetWellKnownJwksJson operation middleware
+func (siw *ServerInterfaceWrapper) GetWellKnownJwksJson(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWellKnownJwksJson(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAccountPassword operation middleware
+func (siw *ServerInterfaceWrapper) PostAccountPassword(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAccountPassword(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAccountRegister operation middleware
+func (siw *ServerInterfaceWrapper) PostAccountRegister(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAccountRegister(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminBroadcast operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminBroadcast(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminBroadcast(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminConversations operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminConversations(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AdminAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminConversations(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-// ParseGetMeResponse parses an HTTP response from a GetMeWithResponse call
-func ParseGetMeResponse(rsp *http.Response) (*GetMeResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// PostAdminConversationsIdEnd operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminConversationsIdEnd(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
 
-	response := &GetMeResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx := r.Context()
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest User
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	ctx = context.WithValue(ctx, AdminAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminConversationsIdEnd(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r)
 }
 
-// ParseGetPingResponse parses an HTTP response from a GetPingWithResponse call
-func ParseGetPingResponse(rsp *http.Response) (*GetPingResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// GetAdminQueue operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminQueue(w http.ResponseWriter, r *http.Request) {
 
-	response := &GetPingResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx := r.Context()
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Pong
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	ctx = context.WithValue(ctx, AdminAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminQueue(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r)
 }
 
-// ParsePostSessionAnonymousResponse parses an HTTP response from a PostSessionAnonymousWithResponse call
-func ParsePostSessionAnonymousResponse(rsp *http.Response) (*PostSessionAnonymousResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// GetAdminUsers operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminUsers(w http.ResponseWriter, r *http.Request) {
 
-	response := &PostSessionAnonymousResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx := r.Context()
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest AnonymousSessionResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	ctx = context.WithValue(ctx, AdminAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminUsers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r)
 }
 
-// ParsePostSessionSkipResponse parses an HTTP response from a PostSessionSkipWithResponse call
-func ParsePostSessionSkipResponse(rsp *http.Response) (*PostSessionSkipResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// PostAdminUsersIdDisconnect operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminUsersIdDisconnect(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
 	}
 
-	response := &PostSessionSkipResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	ctx := r.Context()
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON429 = &dest
+	ctx = context.WithValue(ctx, AdminAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminUsersIdDisconnect(w, r, id)
+	}))
 
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r)
 }
 
-// ParseGetWsChatResponse parses an HTTP response from a GetWsChatWithResponse call
-func ParseGetWsChatResponse(rsp *http.Response) (*GetWsChatResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// PostBlock operation middleware
+func (siw *ServerInterfaceWrapper) PostBlock(w http.ResponseWriter, r *http.Request) {
 
-	response := &GetWsChatResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostBlock(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	return response, nil
+	handler.ServeHTTP(w, r)
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Create a persistent account (username must be unique)
-	// (POST /account/register)
-	PostAccountRegister(w http.ResponseWriter, r *http.Request)
-	// Log in with an existing account
-	// (POST /login)
-	PostLogin(w http.ResponseWriter, r *http.Request)
-	// Return the current user profile
-	// (GET /me)
-	GetMe(w http.ResponseWriter, r *http.Request)
+// GetContacts operation middleware
+func (siw *ServerInterfaceWrapper) GetContacts(w http.ResponseWriter, r *http.Request) {
 
-	// (GET /ping)
-	GetPing(w http.ResponseWriter, r *http.Request)
-	// join the waiting queue
-	// (POST /session/anonymous)
-	PostSessionAnonymous(w http.ResponseWriter, r *http.Request)
-	// Leave the current conversation and rotate immediately
-	// (POST /session/skip)
-	PostSessionSkip(w http.ResponseWriter, r *http.Request)
-	// WebSocket for real‑time chat
-	// (GET /ws/chat)
-	GetWsChat(w http.ResponseWriter, r *http.Request, params GetWsChatParams)
-}
+	ctx := r.Context()
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetContacts(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// PostConversationsIdReport operation middleware
+func (siw *ServerInterfaceWrapper) PostConversationsIdReport(w http.ResponseWriter, r *http.Request) {
 
-// PostAccountRegister operation middleware
-func (siw *ServerInterfaceWrapper) PostAccountRegister(w http.ResponseWriter, r *http.Request) {
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostAccountRegister(w, r)
+		siw.Handler.PostConversationsIdReport(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1096,6 +3599,20 @@ func (siw *ServerInterfaceWrapper) PostLogin(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
+// PostLogout operation middleware
+func (siw *ServerInterfaceWrapper) PostLogout(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostLogout(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetMe operation middleware
 func (siw *ServerInterfaceWrapper) GetMe(w http.ResponseWriter, r *http.Request) {
 
@@ -1130,6 +3647,26 @@ func (siw *ServerInterfaceWrapper) GetPing(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
+// GetQueueStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetQueueStatus(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetQueueStatus(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // PostSessionAnonymous operation middleware
 func (siw *ServerInterfaceWrapper) PostSessionAnonymous(w http.ResponseWriter, r *http.Request) {
 
@@ -1144,6 +3681,20 @@ func (siw *ServerInterfaceWrapper) PostSessionAnonymous(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
+// PostSessionRefresh operation middleware
+func (siw *ServerInterfaceWrapper) PostSessionRefresh(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostSessionRefresh(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // PostSessionSkip operation middleware
 func (siw *ServerInterfaceWrapper) PostSessionSkip(w http.ResponseWriter, r *http.Request) {
 
@@ -1187,6 +3738,30 @@ func (siw *ServerInterfaceWrapper) GetWsChat(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	// ------------- Optional query parameter "lastSeq" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lastSeq", r.URL.Query(), &params.LastSeq)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lastSeq", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "interests" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "interests", r.URL.Query(), &params.Interests)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "interests", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "language" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "language", r.URL.Query(), &params.Language)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "language", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetWsChat(w, r, params)
 	}))
@@ -1318,11 +3893,25 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	m.HandleFunc("GET "+options.BaseURL+"/.well-known/jwks.json", wrapper.GetWellKnownJwksJson)
+	m.HandleFunc("POST "+options.BaseURL+"/account/password", wrapper.PostAccountPassword)
 	m.HandleFunc("POST "+options.BaseURL+"/account/register", wrapper.PostAccountRegister)
+	m.HandleFunc("POST "+options.BaseURL+"/admin/broadcast", wrapper.PostAdminBroadcast)
+	m.HandleFunc("GET "+options.BaseURL+"/admin/conversations", wrapper.GetAdminConversations)
+	m.HandleFunc("POST "+options.BaseURL+"/admin/conversations/{id}/end", wrapper.PostAdminConversationsIdEnd)
+	m.HandleFunc("GET "+options.BaseURL+"/admin/queue", wrapper.GetAdminQueue)
+	m.HandleFunc("GET "+options.BaseURL+"/admin/users", wrapper.GetAdminUsers)
+	m.HandleFunc("POST "+options.BaseURL+"/admin/users/{id}/disconnect", wrapper.PostAdminUsersIdDisconnect)
+	m.HandleFunc("POST "+options.BaseURL+"/block", wrapper.PostBlock)
+	m.HandleFunc("GET "+options.BaseURL+"/contacts", wrapper.GetContacts)
+	m.HandleFunc("POST "+options.BaseURL+"/conversations/{id}/report", wrapper.PostConversationsIdReport)
 	m.HandleFunc("POST "+options.BaseURL+"/login", wrapper.PostLogin)
+	m.HandleFunc("POST "+options.BaseURL+"/logout", wrapper.PostLogout)
 	m.HandleFunc("GET "+options.BaseURL+"/me", wrapper.GetMe)
 	m.HandleFunc("GET "+options.BaseURL+"/ping", wrapper.GetPing)
+	m.HandleFunc("GET "+options.BaseURL+"/queue/status", wrapper.GetQueueStatus)
 	m.HandleFunc("POST "+options.BaseURL+"/session/anonymous", wrapper.PostSessionAnonymous)
+	m.HandleFunc("POST "+options.BaseURL+"/session/refresh", wrapper.PostSessionRefresh)
 	m.HandleFunc("POST "+options.BaseURL+"/session/skip", wrapper.PostSessionSkip)
 	m.HandleFunc("GET "+options.BaseURL+"/ws/chat", wrapper.GetWsChat)
 
@@ -1332,27 +3921,107 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/7xX224bNxN+lQH//yJRt17ZCYpUd0rQFikSwLAb5CI2Ymp3tGK8S66HQytCICBXvW8f",
-	"oY+WJymG1FlrJW3j3OlAznwz3zcHflCFa1pn0bJXgw/KFxNsdPw4tM7OGhf8OXpvnD1D3zrrUf5rybVI",
-	"bDCeLJy9RfKajbPPS/mFZy2qgfJMxlZqnil83xpC/9yeY+FsGa+NHTWa1UAZy49OVLa8ZSxjhSTX2F2j",
-	"7TQ4xZF3xTXyK6o7DswzRXgTDGGpBm8WdnZudaC6XIFwo3dYsHgaBp7cHftdCDsBdJl/NtH8Er3XVbRe",
-	"oi/ItJJKNVBD8MZWNQLaW6xdizB2BHiLNIPXODqPsch3y0cX9sJ++vgX9HrFRHOvBwCffv8Trppk/Aq+",
-	"gys2DXrWTXsFmhBaQo+WAZY3Wy2Ae710c5GeIV+B8avDD6YTtMATBHLBloC29A/XJsTF29D2etHEhsMD",
-	"JnTBQdf1LNmSQIZ1DY4nSFC4ZmRs1JaPoE1lHWEJo1m04JFukY4uhN5/K8shb+mx1IzfC3KVKRvqWo9q",
-	"VAOmgNm+iWbN3WfPrrJxp7v9K/GHDwptaERIwq1EGolSyeTb0G5I6y4Fyr/ZblK6FPkTkaN9pZfI2tT+",
-	"iwLFpYnDmNKxLgwvXGXsGd4E9LwPpdXeTx11cxo8ktUNft796mS2ttgF5tTZqgOESb/ie920kgvVyrnP",
-	"8RCvdXk5w8p4RvpmUXeBeOWR9tvQaRjVpoBbg1NwY9AWdFG4YHmv5sx/RWdE1AcgzjPlsQhkeHYusyq5",
-	"fYqakKRTy7dR/Pbzsr5+ff2bytJkE0vp3zVPE+ZWzcWwsWO3H/y6z0rtxX5jCoSp4Qno5YwEKUhjqwz8",
-	"tWnhIvT7Jz+AFCcBOY7lloG2JbhoV9fLFAJF3ikeSW2MDUc9yWiA4elzlSmp2QTn+Kh/dCw5dS1a3Ro1",
-	"UI+O+kePooh5EtORL2zntNBUlI9LohK6Vj1RnTrPw3R6KUCVGEHPT105W3RSRhtv67atTRHv5++8s+ul",
-	"QT79n3CsBup/+XqryBcrRb6r7/k29dJJ4g9p0sY4TvrHX8391hiPvndG7YKOglAzlpLhx/0fv5r71FQ7",
-	"/L5aaB10TajLGeB749knpYem0TQTKURUoKEVIXjBsxLQg2W5QBM8wwghWHMT8GG0kdfSSg8LIHbbe6J9",
-	"q5N/Eef9b8b5eSgK9D5xfXz/XD/VpeirRMtG17scv3AVGLtsLEkHxlarXhvZTD20wg4mf0F+ieoe0xmH",
-	"Q0dYp+TGpsat5qwGb7bb8pvL+eVmtGfIgdISWAQiEbToGNoNY/lyxt4V76mJE/feIo6TvyNiwQW0oSwB",
-	"69M7KV8NhcNFt3hWrZ5Z6j6b311vua5GuJppi4DWLXFLru+cSfRNtYk6vQkYFrwtUyHD8IuycC4H9xLw",
-	"eH8an8kwxRJ8Kt1xqOtZrN+Tb9CrBSUUuq6xBHYOboIprsX/PxH+C9S3uKX7zZ087ghxY0AwTYOl0YwL",
-	"F/nU53H/P1ARr/2z5QuBdIOM5CMiGQHqJiDN5FETV7HVq3i7IWcbWdrd1S53KDpOGt2ZaG1FuowpWj9S",
-	"d8SzXqrkQUuo608f/5B1KS5ZKffpZZfgB3nlx1VtkOe1K3Q9cZ4HT/pP+mp+Of87AAD//y855ePHEAAA",
+	"H4sIAAAAAAAC/+xc3XIbN5Z+lVO9WxXb06Ek52dmpCtZcbJO7IxHcsoXsUsEuw9JRE2gDaBFc12q8tXe",
+	"e+cN9tH8JFvnHPQf2ZToZKRMavfGFrvR+Dn4zv8B3iWZXZTWoAk+OXyX+GyOC8V/HucLbU6suUTnVdDW",
+	"0MPS2RJd0MhN8G2pHfrjQD+m1i1USA6TXAX8POgFJmkSViUmh4kPTptZcpUm+Dag8doa7iC+1ibgDB29",
+	"13nnefvZAhcTdPyNDrjwg43iA+WcWtFvhwuljTazM8ysyQdH5GZvKu0wTw5/puHbwdLOAgd66y3mdTO8",
+	"nfyCWaDxmYJ/r7DCxya41Sb9MmsMZgG7a55YW6Ay19CC5u7Qh0+kRqHMrFIzHGxcWq/rPd7clKXS4dPJ",
+	"2PSZdha60dlWwv3k0Q2SrAHkE6ZPjj5zupTZJy/mCM5WJocwR6g8OtAetElBT0GZ1WgIlFsI/Ya2bsve",
+	"OJxpT/uw5T2NbNRiiNpDtOp014w7SBljzWphK3+GnoB3im8q9GGTTj2Q9En0JL6CoGY+BRzNRjBeVF5n",
+	"4xGcKI+gTA6+ckxHbWbgS5WhB+UQ9MxYh/mI0a8WZYG0Bv46SZO5vqAlvk5bXC7U26doZmGeHH7xcID2",
+	"C/X2ibQ92L8etP1VPHc4Recwh2yuAtQNQXlQ7a+gZvUK0YzBOhiX4fNHp2NaQGdqB1+nA9u0A/l9aY3H",
+	"XXA6IApZuDwxHb7qr/GpniIJUrBTGAd7gWZ8RJiGscOpQz9/wc9gglNLexPAVcaDrUKStvJYm/DFwxb2",
+	"Hb7u9jIsQ7a+WeLE2+wCw0+uuBnj0s/agGudDBBkkAOqMN9O9l9F01sm1m602GnxjwqbXWzleZI522Qi",
+	"vYNgYUI9wD2PCOOo58awB+Ops4sxWCPchJdogr8/ICyHuOKRsyrPlA9bZ7ZA7yMXd5juq/399AZi1R++",
+	"vn5YXxUDozrMdKlr06ZPk5NaIzFlPGsLY4POEJbKg4hgmFo3GgDD2iQ74wzN82SuzAyfK++X1uVbaZRV",
+	"zqEJdbtBiBlcXvN+bVrrHfY/3zLT8Kzdqj7FjsFrMysQ0FxiYUsk6hBS3Ape4uSMGVmQM3plXpnjqIfn",
+	"ykNYWrAOFtYhRNRFDBLcRjNnq/JM/yeO74/gMWMPwlyFV0bF5uDRhJQVU4TteYHTME4hU86tuHVs+pkX",
+	"sD/5BrR5ZQTZPKOP7/8HHjygER88AICP//Xf1Bkvdwx/gjGJBR/UouRfHt/w/zofs+YrHXpZG8BJwdsN",
+	"C7UCjwHGGT945mdP8vERKOC2OSx1mDO0vFogXKqiQtCegEa9OCzUCnNQM0UGCrWzTs+0UQWMVXYxprYO",
+	"S1SEU218QJWP4JnN0bFioU5oCg5pS5nCyl90FtUqBu3r4Y5kQmhyohV1YZcGMluuwM/t0sOSaMmTCXPa",
+	"qJkNo5p4Krt48IApF2ynHxKmSjZzfBifu0t0TL20S1kaj3eRyJtCWVS+N58+KZtxcyz0JTrMh0c3GYJq",
+	"9z+am9oaQh8PmWVYEhXpq0ieVL4r0cUvj2SzyWzzoENaS0XNXXQg1lsRkXY5R9PM1aGqp2mZeVSRMn5h",
+	"sgIFsr4WGjwm0y/OCzSjg/gm176Mm1aDJa68VC4YXnqxakYWqdWSSEE0tpkjDmHMDcYwt0XOZNcOais9",
+	"hbldCqDMigFvTaGNGIM0IvqgF4xE6nQEZ7QKa+AXy14Rt+H+6y0mopB4gDC3HkmxmFktZNWkM212o/Jz",
+	"J5LxwQPYax/KxsUlbRAxWLi0gUec1vKFdmYruTz/XEGmTMTECP5mMu6BRZk1yJSnbmXdIsW0B5kR5mvz",
+	"bgneNCYNUr88gnHjRTJWqJnBJaBpe4qA3Vhm6xZ0VnyBWII2EGyVzZuVMkR7ix3ByzkamNgwh7m6ROmX",
+	"cI0qm8MMA+OaRw8qC+cql8U00GQBwDwpLcZwr/ZrwOElqgLz+/V2f/f4BezFhh4K7VmI42IEjdUc+dXT",
+	"2KAMjNE561gm8JiZzdm2pUWLhDuvFVorCjrivy8M2KdhRqodQBVgZtETSql/WxFgea8bhq85HJZzC9Sn",
+	"SCeHylszhuV8NYJak6HJPcsMxhsuGdrKsHartZpECbob27gB5+tg6b6kKdhLnlI9tlcr3iCew1mwZfyo",
+	"MiG3S8OaUgDL89YmyvmJyi7oV8OQKaP7LaGdSDEW2Xzu5xV31JKWt6MlKnVdSytm4amjnSd0O/yFracj",
+	"wkaOvcnWWjrqIFJQKs8hx6B0MYIX87qjucrBWMDpFLNQyxnqpCO/fVArD7bsyNdS6VYRrDFXVNJwj4RP",
+	"hyNp6+4zHVqrVyDacL3uthcVNVcO89pd9uN6eo1vLbJEFYXwlyY1uljYxtQgUXRelQ8e8Ez7KmPbTFUW",
+	"KlUUK5kyQN2XyM24agV+5QMuapOVUCwcW6JTwTp/1NkBFveiwhACvo0Y77uoPClclKGVyxEntFTlSI00",
+	"8omeU3uCEL0AwhHZPQf7Bw8hKxqwiGQuCrv0o2tGhFOMey6CgCBsp9OOSvXVotlzHzWPaz+qaVgHfHzQ",
+	"RUEbooAMhwiqZvBBocxeYSsCGpyQZBATQHoloYgjeKa9xzwaPbXu9u2CUxperEjrcnTNYsKqFFqSmvPB",
+	"liXm572HaqlW8c9Ja3FhOccFOlXQGAKfDMHrmVGFj/q4oxzTRgeSjbCmG5gVRDv7YEm/WAcOxdgYwakK",
+	"+PH9h0IvNDM5KwySIN7HASNFcsfTZ4op45esqngLW/Hek+0q4HnsVozyrinNE2XDWahKdhbThf7qU4qe",
+	"EJnG6SszJiLRA7K86P++QdF5Iip/3OCfwEPTODYrUXZAPlFKxFBRSLEWEZsdFJnwOoeOn5QyIXZZ+kTl",
+	"56VaFVbxypN03f9r7d4Bd5Vffnz/ISNBbMi56ZjcKWA2t+wjgA4+Og+8yMZwHg9GPmlqm6M9U9lcG0IA",
+	"UVRNCgRRSjxouzp29g4F12Njw7k25102G8PH9/+APtfXtFx3QqK32msde+6BhrsM1oqdiuIs2imEufZw",
+	"oU1+BL6wS5ZI8fvIm+fB2vNCuRlKJ62A1B4Ka2aNPm/9F8WiK/bT3UHugdYhiPj+7G8/phEjPXQwkojs",
+	"vMYO1qktrT/23aQSZKFxmbQmUQuZMvTFBBvDkqLZMK0cwbYm1KDlxF3VliSI4UtGBEy18wHuNYxwf41e",
+	"kbmlg0XjdTZMz96LiD8yCXOYrFjD9p3hlsxkI5CBwOBHUy0oSjEAmyRNulvOGZm1HUzSpLMb3VSMfNWE",
+	"1NeI0ekrriJ5PcgWbMYSZ/y7w2lymPzbXpso24tZsj1OUUjzHYO916TKTFUUxGrJYXAVDkyKlPwmr/4U",
+	"Yx12Gn1bDpeQzmI1Sc+YScC6lJ2ktfhJbfceyctWXsgrhxnqy77jfE0OpT+3M2aij+8/KE9aA/O6B5rw",
+	"vUbGR+ndHVpE+f3BkTq5wEFSsDgYNuvujcV4lBHYppBBdk+jdUKZN+4YW983gYgThGdBhcpLmJkE7ebi",
+	"XpJhDeNNh0KyGt1NjRtOdjpHrDrcFi1SUj5iCJz7C81s0D7Jte/m6yLjW3eussidaw7EJgulydvPacjP",
+	"LxV7i57GPuWVvdAL/IkmID+fy6BnzSx6j7/pT0XePasndFzPR54L2s6aSV3RRN8MZI4Ykj3ny5OlYDIE",
+	"UzH7dHRrF/TdLMHXXw5mCdZ8hu3Zt+h51Axrpx2YzsibaLB6/4gVxgSjsfxJcG2cjt1T9PLgXYMZIgPD",
+	"IwrQFkMbDgKLXWYr6Vge9S23JE3IcGMJnl3QL/634X7uQ2S6c9YlabKJ+TZJmiZ9Y699ILZe85vbRjAl",
+	"adKLeTQ5f+afJE3ErdoR1ydCn+c1fRqECyBPu9Q5bajzoibGmVCn+X0sxHkkZDnmf7/pEOdUiPOYiMOx",
+	"8iRNumUaj+Na/14T6DGv/7Shj/w+rsnzuCXPSUOeEyHPcaTOM6bOUyHOj5E4G4ktItWGMhzMM7TqdS1r",
+	"Paw8vTYZ7g7gT8y9N83rgYamzOTenLAENfxO2gDrLq6fkzQbmsP3L3/YlCen357An786+DOU1aTQGVzg",
+	"6gjGZrw3xjGr9NOz4xTGmbsc743fyqO//fB8w/1QxWyQ9pm7HHw+XEdysWUHL8Jq8PlwJrXyw72/vZl6",
+	"NJBMI+UlSWdbqHm2uaEXuOrX1VynumlDNmTu+oSow6Hxn9qZ3l6/UV6XBtwd4B1sl9dl/p5bMxuYhJan",
+	"TaVHUlqWUdcPWsYakI1RuqbOxmBNfuGl0mFr+v7UVrM5ZyBABRjX2Ytx2sbAHGZoApC+otCUUwFHcDxh",
+	"c7gyQReAhnuhFl5CdxNEAwuVI8dJsCjEQ9/U75IUGbY9feP+2xJjJl3qG0aDfXWLrvq9HXx8/2GiPOZQ",
+	"FirDXjT3CJSshYNexoY6yTM8SHx5cwY8NuQiAQTR9jskvyNB2oGGNv5Uah22ov2G2oqNfHun9fBw4uzu",
+	"xF19qvzl4/t//PkhTFYBfQqLygfI9XSKrsVXh6fugDNPsbRue2WFsWG9rOJgf6CuoutY1IbdXDnl/UJM",
+	"CF+qRZIm2qiSRnBaBZpbZcjYZoebA2SD7vJ1hSfRvp0g8yIvBvPfVoDSxwKvaohydQHhmvUvavJS4zJG",
+	"s2I4ZEMj6t8qfPvWxeYU2UHJKqfD6ow0CrZ1t1TlNEzPOsIPXEckqBwr+mZUV4gRSPf4ERw/f8KZpem0",
+	"mwEjsrIO46JFVA5dS+h5CCWX1/DzeiLS6tva/vr+5Yub+7jiYtWpHXBmm3oREZLoLrnyRsRnnS2MEjwF",
+	"8lHhVbW///BrjtI7cDYoyVorkzdp9noroRv7iZJcB1ZgZKwTVZI0ISs1itvR/uiApXuJRpU6OUy+GO2P",
+	"vmDeDHPelr3REovi8wtjl2bvl+WFH/0S2WmGzJeyMTEClHyH4SUWxQ/U/Pvlhf/eS1wrVq5xlw/392Op",
+	"YEDDfaiyLHTGvezV3YuxsYMpciYU71OaApNUngM/4ArOMKKuWiyUW7XcQEaKRLov0enpigiJ3gvGPNw7",
+	"PXv41dewB4/zb86OOUx/nzvaiwTf6wrU0voBgjy3PhxL605FUvTaHtl89U8jxXC91VWfO8lGv9rYjy8H",
+	"5EXsJ9Yv5ASTL/+JGyeOxcDO/YhLqMkKuUUJmy8QJahX2kJnK5nNwe3PhrJdYh2Q1LEOls6aWRO1b/a/",
+	"K9SSw5/f9cTIz6+vXnfRJzsly6lXGgMhdce1cO6hrQ5m74S22hi4JbSt2xo74eyft2G9ctiBfYtEgMxx",
+	"Fdmdoff5bsj96+3P5Ke6YkUVDlW+AnyrffCcmslUUUjCuH7ZKcTvS8oTJiAoKElt+NABZ6cqhg3GCUJl",
+	"9JsKayFJqnhvUper3oBaatyUtt4SaDcqdndC7f5tjM+lu0PiTyobYpjvrmD7mCKsdcj3zoVrI1bFeGNZ",
+	"uylROwbiukA9Q5NvFIcEG0PNWc/j62KzG7Pz19k0G4fC/G81anYKtGwMOxB22aBqf5p/uL2k7MZlv0jM",
+	"N6V52kFzHo1N4q27ufdO51d7aHqm2dqUY+3ajCuZBxJMPGqsABiv54Ok0GBmpe4slpkw24rlvUXE9Xbn",
+	"Sf7YxBSUWmDg7N7P7xJNsyMLPEkTcbnq01JdQZV2tmzdIXu9i4knEfN/DYDQJL68A+PSgq+yOahNkH0S",
+	"SB+zvOl+DsYuu2BscqDXihSODN6dKOkcytxBkHBrQBOcxj+gIKGoQR3ck3pQihrU9R/dveJA4I17xaHO",
+	"u9urushiB3HfjWf+8fbp+hDyxj6JYG+T9dvl+4tOcXQnyYzBr9UNWPdr5L+U9NKc4mGUOKFrxT8v9kne",
+	"Jvh/N+nfqzH4P6UEaA/YNeus/xPwesJlvop3/jMfYSpl3OqCvfoF2KpGnXaCO8ExH0PcjtiXMVY5lsjy",
+	"OJVzi1Kny1WiUq3ZL54Qb+4z3wQPrINCBUI+Dz0CPj9JdPd2gdZg5zOuAlbFRUyBqMJbXofvtmmWo2KX",
+	"KShPJ8u5EJzq2WM5zTbs8wRuy6nrHg69ih7dTejnj+7QwXqmCkriU7GgzVcpKPBYTCndRRNhp7zedDCI",
+	"eawtXgi//B78qY2UeP4+Blrjqe0cVPuRq7qbFF5UKNyRnDQUDqxP6nTU/fp5z9iEOINTo1Jlv7TdY0mS",
+	"O+QzRrFwOxaTQl5x+lXVvNeeApJvOO9qbD2KH2KZ7zDEWpG7MTjiYDuaGzKv3x+Sn4KO52jLoif32qgA",
+	"ISUFW+Toe8bhgD8pibztArzh4Vi5xscqSHrWJzUcH4QVUW6wJ85H8NK6C8+VKmoNNMHao5hFlPsoLrAM",
+	"8exfNEv8Ntm75nFKYvXWzI7biDR3M8G/Np8hvcBUF3co9R+pvDlBwCYH/r+k7wQ6d7Je+hSj4l5j46m/",
+	"TxMBEQHqE60nkQUFVS1dH8HmwqZbsnF6RVN3HLS+KdVyVnHS9M6QS0yVOczRBK0Kv5aveGpnBI7aneSk",
+	"B+vjbkKtsDNbhRu301bh1rJnvcKgXyvUntrZDHNWMPfYcncYKmfkBg+oDGftYzb7/hqhTvHSXiAftua5",
+	"1IUVaqGLFdyrj7/H85okpTq58ZjhWVwb4XqGt5n5l/jIQAbOWRLynyobiG69DCwbjmWns726LHDbep83",
+	"leC3s2IuVhxYcSklRi2H0mQ54rXnm6LDQVv3W+uaw1ns3CmHfc8YVhiOOj/r1jMM7QUfuQqKT33yoHk8",
+	"iOFH8FJscCmTEeSkneLF+gRqI4I3q/4aJ1O5Jpq3xWLuVlne4i50h9kaNPXNuZY7EYnHPRI3J2BgUjVn",
+	"OFnFbzDFVXpDpUJkBtmLgivtUijFmO5clNG/JEPYxctdYXuNLbvdbG4Ph1Bn7Z1mDpsaJ4m2LVTIyGKW",
+	"I3yFtdFejjPi419z+kyZ5rg699nejXYEahrQxSt4uEOqvB2vwS7EKIgyK6ocg+hAdJxLbWIflJEK2fzE",
+	"2oLvFZCUP/ucYunHilXy/AELjzDVYau5Hq9Ya9zGW9JA227UGwygHNzisNfUcHTuz+DGd17NsR61sa49",
+	"ghv/pgO00N46eJUmX+1/cfszO9t2F4HD4FY1xE/px+fH9GO8pv5/sRHvvRRJn3OjZbCdb0+7poOX6kQ8",
+	"lGPzfIuGMlwV4jEHfKuywG5vRuwkZgUNrExdhfLx/Qduyt2BYwPF88nu5dwWWNsmxM9i6Hj4cv/gBkaK",
+	"c/wXMuTuzjSnWjohJsmtO1NHPVjUZmgKchY4T+POxrsXaMfXsPn4bbw0acM45WPlBpe1MfqntQayzC6G",
+	"KTB9vaEfcUJHMZOdIgmM8pzilDSHaVUUUtX18A6qumiWYi/lLH7eVDq7KFafZu0+RYpBdo3dXiab+YsX",
+	"CXqxwFyrgHGIvaXfI5233aJ0fIsYH1bfuFbgMw98Sh3uHXwNP+hHdG1HjlNVFeF+vD2Fr5qJKRX24cSS",
+	"goP9/b+O+IqB5sqRpuhMohc/vfj24/sPf0nBWPPx/Qc+M5q+MlOHfOclbYuzBaUWncoCOl/ffEFzNLhs",
+	"L/1SEymvprGsD6/Mw/39/e6H7bSPYicTlcu9Get3PDUXAw5ZrC/9SX3GdDMU96ZCt2pjce31mbuG49L1",
+	"vfkPPZujj7XnckMLY0CuOpqz0YiGLgZjxuveTCO64pWRU64wc4rL1k1O971kvV0R1aPFl2ivVomprVdG",
+	"hw4iYoN4+V5jt/J5RGkmUIiXFggdh8hTKGLkN0mXIDceW75KtxmifEewxFfJtONqfOWBThYM201H8cLC",
+	"VybeNiP7yV14ykyIBas9qey5XW7kEuTaAdLK0oWYoRwwdqoFapg7PsO1aV2n4MUnW4xeme/0JblaOgiB",
+	"s5jOiynLxlw5ArvQIZBMbpwsiixvp3PzaZIOJRl+y63HV+mOdx3fsBUjuH71rSPQLF4uEOOVb8WXfNRb",
+	"9g0XKa+n4Q9E964lo8uZUzlL8/Y60TszcF9ca82mvcf966XXVHYzdUa8Q1V8fP+Bb5ZidcFDC8uLiKtc",
+	"kRwme6rUydXrq/8dAGQE3LuPXwAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -1,7 +1,22 @@
 package main
 
-import "backend/server"
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"backend/config"
+	"backend/server"
+)
 
 func main() {
-	server.StartServer()
-}
\ No newline at end of file
+	path := flag.String("config", os.Getenv("CONFIG_FILE"), "path to a YAML config file (env: CONFIG_FILE)")
+	flag.Parse()
+
+	cfg, err := config.Load(*path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	server.StartServer(cfg)
+}
@@ -0,0 +1,378 @@
+// backend/config/config.go
+// Typed backend configuration: defaults, then an optional YAML file, then
+// KK_* environment overrides, validated once at startup.
+
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+)
+
+// EnvPrefix namespaces every override, e.g. chat.roundDuration → KK_CHAT_ROUND_DURATION.
+const EnvPrefix = "KK"
+
+// MaxGroupSize bounds chat.groupSize; bigger rounds stop feeling like a chat.
+const MaxGroupSize = 6
+
+type Config struct {
+	Env        string           `yaml:"env"` // dev | staging | prod
+	Server     ServerConfig     `yaml:"server"`
+	Log        LogConfig        `yaml:"log"`
+	Store      StoreConfig      `yaml:"store"`
+	Auth       AuthConfig       `yaml:"auth"`
+	Chat       ChatConfig       `yaml:"chat"`
+	WS         WSConfig         `yaml:"websocket"`
+	Moderation ModerationConfig `yaml:"moderation"`
+	Admin      AdminConfig      `yaml:"admin"`
+}
+
+type ServerConfig struct {
+	Addr string `yaml:"addr"` // host:port to listen on
+	// ShutdownTimeout bounds the drain after SIGTERM: notifying clients,
+	// waiting for sockets to close and in‑flight requests to finish.
+	ShutdownTimeout time.Duration `yaml:"shutdownTimeout"`
+}
+
+type LogConfig struct {
+	Level  string `yaml:"level"`  // debug | info | warn | error
+	Format string `yaml:"format"` // text | json
+}
+
+type StoreConfig struct {
+	Driver string `yaml:"driver"` // memory | sqlite
+	Path   string `yaml:"path"`   // sqlite database file
+}
+
+type AuthConfig struct {
+	AccessTokenTTL time.Duration `yaml:"accessTokenTTL"`
+	AnonSessionTTL time.Duration `yaml:"anonSessionTTL"` // refresh family lifetime, anonymous users
+	RegisteredTTL  time.Duration `yaml:"registeredTTL"`  // refresh family lifetime, registered users
+	JWT            JWTConfig     `yaml:"jwt"`
+}
+
+// JWTConfig lists every key accepted for verification; ActiveKid signs new
+// tokens. With no keys (dev only) a random key is generated per process.
+type JWTConfig struct {
+	Issuer    string      `yaml:"issuer"`
+	Audience  string      `yaml:"audience"`
+	ActiveKid string      `yaml:"activeKid"`
+	Keys      []KeyConfig `yaml:"keys" env:"-"`
+}
+
+// KeyConfig describes one key. HS256 keys need Secret; RS256 and EdDSA keys
+// need a PEM private key to sign, or only a PEM public key if they are kept
+// around just to verify tokens issued before a rotation.
+type KeyConfig struct {
+	ID             string `yaml:"kid"`
+	Algorithm      string `yaml:"alg"` // HS256 | RS256 | EdDSA
+	Secret         string `yaml:"secret"`
+	PrivateKeyFile string `yaml:"privateKeyFile"`
+	PublicKeyFile  string `yaml:"publicKeyFile"`
+}
+
+type ChatConfig struct {
+	RoundDuration time.Duration `yaml:"roundDuration"`
+	SkipCooldown  time.Duration `yaml:"skipCooldown"` // throttle rapid skips → 429
+	// GroupSize is how many users share a round: 2 is 1‑on‑1, at most
+	// MaxGroupSize. A round starts once that many are waiting and goes on
+	// while at least two of them remain.
+	GroupSize int `yaml:"groupSize"`
+	// MatchWait is how long a queued user holds out for people who share an
+	// interest and language before taking anyone. 0 matches at random.
+	MatchWait time.Duration `yaml:"matchWait"`
+	// RematchCooldown keeps people who were paired within it apart: they
+	// meet again only when no other group can be formed, and not while
+	// either is still within MatchWait. 0 disables.
+	RematchCooldown time.Duration `yaml:"rematchCooldown"`
+	// ResumeGrace is how long a dropped user keeps their seat in a
+	// conversation; reconnecting within it resumes the round. 0 disables.
+	ResumeGrace  time.Duration `yaml:"resumeGrace"`
+	ReplayBuffer int           `yaml:"replayBuffer"` // chat messages kept per conversation for resume
+	// Presence signals (typing, away, …) per connection: a token bucket
+	// refilled at PresenceRate per second holding up to PresenceBurst.
+	PresenceRate  float64 `yaml:"presenceRate"`
+	PresenceBurst int     `yaml:"presenceBurst"`
+	// MaxMessageLength caps one chat message, in characters.
+	MaxMessageLength int `yaml:"maxMessageLength"`
+	// A round everyone votes to extend gets ExtendBy more time, at most
+	// MaxExtensions times (0 disables extensions).
+	ExtendBy      time.Duration `yaml:"extendBy"`
+	MaxExtensions int           `yaml:"maxExtensions"`
+}
+
+// WSConfig tunes each chat socket. Events wait in a per‑connection outbox of
+// SendQueue frames; a client that lets it fill up is disconnected. The server
+// pings every PingInterval, and a peer that sends nothing (pongs included)
+// for IdleTimeout is declared dead.
+type WSConfig struct {
+	SendQueue    int           `yaml:"sendQueue"`
+	WriteTimeout time.Duration `yaml:"writeTimeout"` // per frame
+	PingInterval time.Duration `yaml:"pingInterval"`
+	IdleTimeout  time.Duration `yaml:"idleTimeout"`
+	// MaxFrameBytes caps one inbound frame; a bigger one closes the socket
+	// with 1009 before it is even buffered.
+	MaxFrameBytes int64 `yaml:"maxFrameBytes"`
+}
+
+// ModerationConfig sets up the chat message filter chain. Filters run in the
+// listed order:
+//   - wordList redacts BlockedWords (whole words, any case, any script)
+//   - contactInfo strips email addresses, links and phone numbers from
+//     anonymous users
+//   - repeats squeezes runs of one character to MaxRepeat and drops
+//     messages that are nothing but such a run
+type ModerationConfig struct {
+	Filters      []string `yaml:"filters"`
+	BlockedWords []string `yaml:"blockedWords"`
+	MaxRepeat    int      `yaml:"maxRepeat"`
+}
+
+// AdminConfig guards the /admin API. Operators present Token as a bearer
+// token; leave it empty to switch the API off.
+type AdminConfig struct {
+	Token string `yaml:"token"`
+}
+
+// Default is what an empty file yields: a local in‑memory dev server.
+func Default() Config {
+	return Config{
+		Env:    "dev",
+		Server: ServerConfig{Addr: "0.0.0.0:3000", ShutdownTimeout: 20 * time.Second},
+		Log:    LogConfig{Level: "debug", Format: "text"},
+		Store:  StoreConfig{Driver: "memory"},
+		Auth: AuthConfig{
+			AccessTokenTTL: 15 * time.Minute,
+			AnonSessionTTL: 7 * 24 * time.Hour,
+			RegisteredTTL:  30 * 24 * time.Hour,
+			JWT: JWTConfig{
+				Issuer:   "knock-knock",
+				Audience: "knock-knock-api",
+			},
+		},
+		Chat: ChatConfig{
+			RoundDuration:    3 * time.Minute,
+			SkipCooldown:     10 * time.Second,
+			GroupSize:        2,
+			MatchWait:        15 * time.Second,
+			RematchCooldown:  10 * time.Minute,
+			ResumeGrace:      20 * time.Second,
+			ReplayBuffer:     20,
+			PresenceRate:     2,
+			PresenceBurst:    5,
+			MaxMessageLength: 2000,
+			ExtendBy:         2 * time.Minute,
+			MaxExtensions:    2,
+		},
+		WS: WSConfig{
+			SendQueue:     32,
+			WriteTimeout:  10 * time.Second,
+			PingInterval:  25 * time.Second,
+			IdleTimeout:   60 * time.Second,
+			MaxFrameBytes: 16 << 10,
+		},
+		Moderation: ModerationConfig{
+			Filters:   []string{"wordList", "contactInfo", "repeats"},
+			MaxRepeat: 8,
+		},
+	}
+}
+
+// Load builds the configuration from path (may be empty) and the process
+// environment. ${VAR} references inside the file are expanded first, so
+// secrets can stay out of the YAML.
+func Load(path string) (*Config, error) {
+	cfg := Default()
+	if path != "" {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read config: %w", err)
+		}
+		dec := yaml.NewDecoder(bytes.NewReader([]byte(os.ExpandEnv(string(raw)))))
+		dec.KnownFields(true) // typos should fail loudly, not fall back to defaults
+		if err := dec.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("parse config %s: %w", path, err)
+		}
+	}
+	if err := applyEnv(&cfg, os.LookupEnv); err != nil {
+		return nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	return &cfg, nil
+}
+
+// Validate reports every problem at once so a bad deploy fails with one log line.
+func (c *Config) Validate() error {
+	var errs []error
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf(format, args...))
+		}
+	}
+	check(oneOf(c.Env, "dev", "staging", "prod"), "env: %q is not dev, staging or prod", c.Env)
+	check(c.Server.Addr != "", "server.addr: required")
+	check(c.Server.ShutdownTimeout > 0, "server.shutdownTimeout: must be positive")
+	check(oneOf(c.Log.Level, "debug", "info", "warn", "error"), "log.level: %q is not debug, info, warn or error", c.Log.Level)
+	check(oneOf(c.Log.Format, "text", "json"), "log.format: %q is not text or json", c.Log.Format)
+	check(oneOf(c.Store.Driver, "memory", "sqlite"), "store.driver: %q is not memory or sqlite", c.Store.Driver)
+	check(c.Store.Driver != "sqlite" || c.Store.Path != "", "store.path: required for sqlite")
+	check(c.Auth.AccessTokenTTL > 0, "auth.accessTokenTTL: must be positive")
+	check(c.Auth.AnonSessionTTL >= c.Auth.AccessTokenTTL, "auth.anonSessionTTL: must be at least auth.accessTokenTTL")
+	check(c.Auth.RegisteredTTL >= c.Auth.AccessTokenTTL, "auth.registeredTTL: must be at least auth.accessTokenTTL")
+	check(len(c.Auth.JWT.Keys) > 0 || c.Env == "dev", "auth.jwt.keys: required outside dev")
+	check(len(c.Auth.JWT.Keys) == 0 || c.Auth.JWT.ActiveKid != "", "auth.jwt.activeKid: required when keys are configured")
+	check(c.Chat.RoundDuration > 0, "chat.roundDuration: must be positive")
+	check(c.Chat.SkipCooldown >= 0, "chat.skipCooldown: must not be negative")
+	check(c.Chat.GroupSize >= 2 && c.Chat.GroupSize <= MaxGroupSize, "chat.groupSize: must be between 2 and %d", MaxGroupSize)
+	check(c.Chat.MatchWait >= 0, "chat.matchWait: must not be negative")
+	check(c.Chat.RematchCooldown >= 0, "chat.rematchCooldown: must not be negative")
+	check(c.Chat.ResumeGrace >= 0, "chat.resumeGrace: must not be negative")
+	check(c.Chat.ReplayBuffer >= 0, "chat.replayBuffer: must not be negative")
+	check(c.Chat.PresenceRate > 0, "chat.presenceRate: must be positive")
+	check(c.Chat.PresenceBurst >= 1, "chat.presenceBurst: must be at least 1")
+	check(c.Chat.MaxMessageLength > 0, "chat.maxMessageLength: must be positive")
+	check(c.Chat.MaxExtensions <= 0 || c.Chat.ExtendBy > 0, "chat.extendBy: must be positive when chat.maxExtensions is set")
+	check(c.Chat.MaxExtensions >= 0, "chat.maxExtensions: must not be negative")
+	check(c.WS.MaxFrameBytes >= int64(4*c.Chat.MaxMessageLength)+1024,
+		"websocket.maxFrameBytes: must hold a chat.maxMessageLength message (4 bytes per character plus 1 KiB)")
+	check(c.Chat.ReplayBuffer < c.WS.SendQueue, "chat.replayBuffer: must be smaller than websocket.sendQueue so a replay fits the outbox")
+	check(c.WS.SendQueue > 0, "websocket.sendQueue: must be positive")
+	check(c.WS.WriteTimeout > 0, "websocket.writeTimeout: must be positive")
+	check(c.WS.PingInterval > 0, "websocket.pingInterval: must be positive")
+	check(c.WS.IdleTimeout > c.WS.PingInterval, "websocket.idleTimeout: must be longer than websocket.pingInterval")
+	seen := map[string]bool{}
+	for _, f := range c.Moderation.Filters {
+		check(oneOf(f, "wordList", "contactInfo", "repeats"), "moderation.filters: %q is not wordList, contactInfo or repeats", f)
+		check(!seen[f], "moderation.filters: %q is listed twice", f)
+		seen[f] = true
+	}
+	check(c.Moderation.MaxRepeat >= 2, "moderation.maxRepeat: must be at least 2")
+	check(c.Admin.Token == "" || len(c.Admin.Token) >= 32, "admin.token: must be at least 32 characters")
+	return errors.Join(errs...)
+}
+
+func oneOf(v string, allowed ...string) bool {
+	for _, a := range allowed {
+		if v == a {
+			return true
+		}
+	}
+	return false
+}
+
+// ─── ENV OVERRIDES ─────────────────────────────────────────────────────────
+
+// applyEnv walks cfg and overrides every scalar field whose KK_* variable is
+// set. The variable name is the yaml path in SCREAMING_SNAKE_CASE. PORT is
+// still honoured for existing deployments unless KK_SERVER_ADDR is set too.
+func applyEnv(cfg *Config, lookup func(string) (string, bool)) error {
+	if err := walkEnv(reflect.ValueOf(cfg).Elem(), EnvPrefix, lookup); err != nil {
+		return err
+	}
+	isSet := func(name string) bool { _, ok := lookup(EnvPrefix + "_" + name); return ok }
+	if port, ok := lookup("PORT"); ok && port != "" && !isSet("SERVER_ADDR") {
+		host := cfg.Server.Addr
+		if i := strings.LastIndex(host, ":"); i >= 0 {
+			host = host[:i]
+		}
+		cfg.Server.Addr = host + ":" + port
+	}
+	return nil
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+func walkEnv(v reflect.Value, prefix string, lookup func(string) (string, bool)) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Tag.Get("env") == "-" {
+			continue
+		}
+		name := prefix + "_" + screamingSnake(strings.Split(f.Tag.Get("yaml"), ",")[0])
+		fv := v.Field(i)
+		if f.Type.Kind() == reflect.Struct {
+			if err := walkEnv(fv, name, lookup); err != nil {
+				return err
+			}
+			continue
+		}
+		raw, ok := lookup(name)
+		if !ok {
+			continue
+		}
+		if err := setFromString(fv, raw); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func setFromString(v reflect.Value, raw string) error {
+	switch {
+	case v.Type() == durationType:
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+	case v.Kind() == reflect.String:
+		v.SetString(raw)
+	case v.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case v.Kind() >= reflect.Int && v.Kind() <= reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case v.Kind() == reflect.Float64:
+		n, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(n)
+	case v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.String:
+		var items []string
+		for _, s := range strings.Split(raw, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				items = append(items, s)
+			}
+		}
+		v.Set(reflect.ValueOf(items))
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
+
+// screamingSnake turns "roundDuration" into "ROUND_DURATION" and
+// "accessTokenTTL" into "ACCESS_TOKEN_TTL".
+func screamingSnake(s string) string {
+	var b strings.Builder
+	rs := []rune(s)
+	for i, r := range rs {
+		if i > 0 && unicode.IsUpper(r) &&
+			(unicode.IsLower(rs[i-1]) || (i+1 < len(rs) && unicode.IsLower(rs[i+1]))) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return b.String()
+}
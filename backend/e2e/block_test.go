@@ -0,0 +1,90 @@
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"backend/api"
+	"backend/config"
+)
+
+// A block holds for good: not while the two are picky, not once matchWait
+// has widened them to anyone, and not in the rematch fallback when they are
+// the only two waiting.
+func TestBlockedPairIsNeverMatchedAgain(t *testing.T) {
+	for _, cooldown := range []time.Duration{0, 10 * time.Minute} {
+		for _, blocker := range []int{0, 1} {
+			t.Run(fmt.Sprintf("cooldown %v, user %d blocks", cooldown, blocker), func(t *testing.T) {
+				testBlockedPair(t, cooldown, blocker)
+			})
+		}
+	}
+}
+
+func testBlockedPair(t *testing.T, cooldown time.Duration, blocker int) {
+	const wait = 100 * time.Millisecond
+	_, ts, c := newServer(t, func(cfg *config.Config) {
+		cfg.Chat.MatchWait = wait
+		cfg.Chat.RematchCooldown = cooldown
+	})
+	tokens := []string{anonymous(t, c), anonymous(t, c)}
+	ws := []*websocket.Conn{dial(t, ts, tokens[0]), dial(t, ts, tokens[1])}
+	t.Cleanup(func() { ws[0].Close(); ws[1].Close() })
+	next(t, ws[0], api.Paired, 2*time.Second)
+	next(t, ws[1], api.Paired, 2*time.Second)
+
+	resp, err := c.PostBlockWithResponse(context.Background(), api.BlockRequest{}, bearer(tokens[blocker]))
+	if err != nil {
+		t.Fatalf("block: %v", err)
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		t.Fatalf("block: %s %s", resp.Status(), resp.Body)
+	}
+	// both are back in the queue, alone together, well past matchWait
+	next(t, ws[0], api.Queued, 2*time.Second)
+	next(t, ws[1], api.Queued, 2*time.Second)
+	time.Sleep(4 * wait)
+
+	// a newcomer is taken by one of them; the other is left waiting
+	third := dial(t, ts, anonymous(t, c))
+	t.Cleanup(func() { third.Close() })
+	paired := next(t, third, api.Paired, 2*time.Second)
+	if paired.Members == nil || len(*paired.Members) != 2 {
+		t.Fatalf("newcomer paired with members %v", paired.Members)
+	}
+	var left *websocket.Conn
+	for i, w := range ws {
+		me := meID(t, c, tokens[i])
+		if (*paired.Members)[0] != me && (*paired.Members)[1] != me {
+			left = w
+		}
+	}
+	if left == nil {
+		t.Fatalf("newcomer paired with both blocked users: %v", *paired.Members)
+	}
+	_ = left.SetReadDeadline(time.Now().Add(4 * wait))
+	for {
+		var msg api.ChatMessage
+		if err := left.ReadJSON(&msg); err != nil {
+			break // nothing more within the window
+		}
+		if msg.Type == api.Paired {
+			t.Fatalf("blocked user paired: %v", msg.Members)
+		}
+	}
+}
+
+// meID is the user ID behind token.
+func meID(t *testing.T, c *api.ClientWithResponses, token string) string {
+	t.Helper()
+	resp, err := c.GetMeWithResponse(context.Background(), bearer(token))
+	if err != nil || resp.JSON200 == nil {
+		t.Fatalf("GET /me: %v", err)
+	}
+	return resp.JSON200.Id
+}
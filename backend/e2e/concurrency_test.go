@@ -0,0 +1,167 @@
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"backend/api"
+	"backend/config"
+)
+
+// These tests exist for `go test -race`: they drive pairing, relaying,
+// skips, round timeouts, registration and disconnects from many goroutines
+// at once and only assert that the server keeps answering.
+
+func TestConcurrentSessionsSkipsAndDisconnects(t *testing.T) {
+	impl, ts, c := newServer(t, func(cfg *config.Config) {
+		cfg.Chat.RoundDuration = 100 * time.Millisecond
+		cfg.Chat.SkipCooldown = 0
+	})
+
+	const workers = 24
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			churn(t, ts.URL, c, i, dialer(t, ts.URL))
+		}(i)
+	}
+	wg.Wait()
+
+	// leave a few sockets open so Shutdown has live clients to drain
+	var readers sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		ws := dialer(t, ts.URL)(anonymous(t, c))
+		readers.Add(1)
+		go func() {
+			defer readers.Done()
+			for {
+				if _, _, err := ws.ReadMessage(); err != nil {
+					return
+				}
+			}
+		}()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := impl.Shutdown(ctx); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+	readers.Wait()
+}
+
+func TestConcurrentReconnectsOfOneUser(t *testing.T) {
+	_, ts, c := newServer(t, nil)
+	dial := dialer(t, ts.URL)
+	token := anonymous(t, c)
+	partner := dial(anonymous(t, c))
+	defer partner.Close()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for k := 0; k < 5; k++ {
+				ws := dial(token)
+				_ = ws.SetReadDeadline(time.Now().Add(50 * time.Millisecond))
+				_, _, _ = ws.ReadMessage()
+				ws.Close()
+			}
+		}()
+	}
+	wg.Wait()
+
+	// the user is still usable afterwards
+	if resp, err := c.GetMeWithResponse(context.Background(), bearer(token)); err != nil || resp.JSON200 == nil {
+		t.Fatalf("GetMe after reconnects: %v", err)
+	}
+}
+
+// dialer is dial for goroutines: failures are reported with Errorf, since
+// Fatalf must only be called from the test goroutine.
+func dialer(t *testing.T, base string) func(token string) *websocket.Conn {
+	return func(token string) *websocket.Conn {
+		ws, _, err := websocket.DefaultDialer.Dial(wsURL(base, token), nil)
+		if err != nil {
+			t.Errorf("dial: %v", err)
+			return nil
+		}
+		return ws
+	}
+}
+
+// churn is one simulated user: connect, chat with whoever it is paired
+// with, skip, look itself up, maybe register, disconnect, repeat.
+func churn(t *testing.T, base string, c *api.ClientWithResponses, i int, dial func(string) *websocket.Conn) {
+	ctx := context.Background()
+	resp, err := c.PostSessionAnonymousWithResponse(ctx, api.AnonymousSessionRequest{})
+	if err != nil || resp.JSON201 == nil {
+		t.Errorf("worker %d: anonymous session: %v", i, err)
+		return
+	}
+	token := resp.JSON201.Token
+	register := i%8 == 0 // bcrypt is slow under -race; a few are enough
+
+	for round := 0; round < 4; round++ {
+		ws := dial(token)
+		if ws == nil {
+			return
+		}
+		convs := make(chan string, 16)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for {
+				var msg api.ChatMessage
+				if err := ws.ReadJSON(&msg); err != nil {
+					return
+				}
+				if msg.Type == api.Paired {
+					select {
+					case convs <- msg.ConversationId:
+					default:
+					}
+				}
+			}
+		}()
+
+		for k := 0; k < 6; k++ {
+			switch (i + k) % 4 {
+			case 0:
+				if _, err := c.PostSessionSkipWithResponse(ctx, bearer(token)); err != nil {
+					t.Errorf("worker %d: skip: %v", i, err)
+				}
+			case 1:
+				if _, err := c.GetMeWithResponse(ctx, bearer(token)); err != nil {
+					t.Errorf("worker %d: me: %v", i, err)
+				}
+			case 2:
+				if register {
+					register = false
+					name := fmt.Sprintf("racer-%d", i)
+					if _, err := c.PostAccountRegisterWithResponse(ctx,
+						api.RegisterRequest{Username: name, Password: "correct horse " + name},
+						bearer(token)); err != nil {
+						t.Errorf("worker %d: register: %v", i, err)
+					}
+				}
+			default:
+				select {
+				case id := <-convs:
+					text := fmt.Sprintf("hi from %d", i)
+					_ = ws.WriteJSON(api.ChatMessage{Type: api.Chat, ConversationId: id, Message: &text})
+				case <-time.After(20 * time.Millisecond):
+				}
+			}
+		}
+		ws.Close()
+		<-done
+	}
+}
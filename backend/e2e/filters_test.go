@@ -0,0 +1,111 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"backend/api"
+	"backend/config"
+)
+
+func moderation(cfg *config.Config) {
+	cfg.Moderation.Filters = []string{"wordList", "contactInfo", "repeats"}
+	cfg.Moderation.BlockedWords = []string{"darn", "сука", "Scheiße"}
+	cfg.Moderation.MaxRepeat = 3
+}
+
+func TestMessageFilters(t *testing.T) {
+	_, ts, c := newServer(t, moderation)
+	a, b, conv := pair(t, ts, c)
+
+	cases := []struct {
+		name, sent, relayed string
+	}{
+		{"clean", "hello there", "hello there"},
+		{"blocked word", "oh darn it", "oh **** it"},
+		{"blocked word, any case", "DARN!", "****!"},
+		{"blocked words back to back", "darn darn", "**** ****"},
+		{"blocked word inside another", "darned darning", "darned darning"},
+		{"blocked Cyrillic word", "ты сука", "ты ****"},
+		{"blocked Cyrillic word, any case", "СУКА.", "****."},
+		{"Cyrillic word containing a blocked one", "сукастый", "сукастый"},
+		{"blocked word with ß", "so eine scheiße", "so eine *******"},
+		{"URL", "see https://example.com/x?y=1 now", "see [link removed] now"},
+		{"www link", "www.example.org", "[link removed]"},
+		{"bare domain", "find me on foo.gg/bar", "find me on [link removed]"},
+		{"email", "mail bob.smith+chat@mail.example.co.uk", "mail [email removed]"},
+		{"phone number", "call +1 (555) 123-4567 later", "call [number removed] later"},
+		{"short numbers", "born 1999, won 3-1", "born 1999, won 3-1"},
+		{"long run squeezed", "soooooo good!!!!!", "sooo good!!!"},
+		{"run at the limit", "hmmm", "hmmm"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			sent := tc.sent
+			if err := a.WriteJSON(api.ChatMessage{Type: api.Chat, ConversationId: conv, Message: &sent}); err != nil {
+				t.Fatalf("write: %v", err)
+			}
+			got := next(t, b, api.Chat, 2*time.Second)
+			if got.Message == nil || *got.Message != tc.relayed {
+				t.Fatalf("relayed %q, want %q", deref(got.Message), tc.relayed)
+			}
+		})
+	}
+}
+
+func TestRepeatedCharactersAreDropped(t *testing.T) {
+	_, ts, c := newServer(t, moderation)
+	a, b, conv := pair(t, ts, c)
+
+	spam, id := "!!!!!!!!!! !!!!", "m-1"
+	if err := a.WriteJSON(api.ChatMessage{Type: api.Chat, ConversationId: conv, Message: &spam, ClientMsgId: &id}); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	ev := next(t, a, api.ErrorEvent, 2*time.Second)
+	if ev.Code == nil || *ev.Code != api.MessageDropped {
+		t.Fatalf("code = %v, want %s", ev.Code, api.MessageDropped)
+	}
+	if ev.ClientMsgId == nil || *ev.ClientMsgId != id {
+		t.Fatalf("clientMsgId = %v, want %s", ev.ClientMsgId, id)
+	}
+
+	// the partner never saw it
+	after := "after"
+	if err := a.WriteJSON(api.ChatMessage{Type: api.Chat, ConversationId: conv, Message: &after}); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if got := next(t, b, api.Chat, 2*time.Second); deref(got.Message) != after {
+		t.Fatalf("partner got %q, want %q", deref(got.Message), after)
+	}
+}
+
+func TestContactInfoAllowedFromRegisteredUsers(t *testing.T) {
+	_, ts, c := newServer(t, moderation)
+	token := anonymous(t, c)
+	reg, err := c.PostAccountRegisterWithResponse(context.Background(),
+		api.RegisterRequest{Username: "alice", Password: "correct horse"}, bearer(token))
+	if err != nil || reg.JSON201 == nil {
+		t.Fatalf("register: %v %s", err, reg.Body)
+	}
+	a := dial(t, ts, reg.JSON201.Token)
+	b := dial(t, ts, anonymous(t, c))
+	t.Cleanup(func() { a.Close(); b.Close() })
+	conv := next(t, a, api.Paired, 2*time.Second).ConversationId
+	next(t, b, api.Paired, 2*time.Second)
+
+	text := "alice@example.com, https://example.com, +1 555 123 4567"
+	if err := a.WriteJSON(api.ChatMessage{Type: api.Chat, ConversationId: conv, Message: &text}); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if got := next(t, b, api.Chat, 2*time.Second); deref(got.Message) != text {
+		t.Fatalf("relayed %q, want it untouched", deref(got.Message))
+	}
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return "<nil>"
+	}
+	return *s
+}
@@ -0,0 +1,115 @@
+package e2e
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"backend/api"
+	"backend/config"
+	"backend/ops"
+)
+
+func TestMain(m *testing.M) {
+	// the server logs every request at info; keep test output readable
+	slog.SetDefault(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	os.Exit(m.Run())
+}
+
+// newServer runs an in‑process server on the default config after tweak
+// (may be nil) has adjusted it. It is drained and closed when t ends.
+func newServer(t *testing.T, tweak func(*config.Config)) (*ops.Server, *httptest.Server, *api.ClientWithResponses) {
+	t.Helper()
+	cfg := config.Default()
+	if tweak != nil {
+		tweak(&cfg)
+	}
+	impl, err := ops.New(&cfg, nil)
+	if err != nil {
+		t.Fatalf("ops.New: %v", err)
+	}
+	ts := httptest.NewServer(api.Handler(impl))
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = impl.Shutdown(ctx)
+		ts.Close()
+	})
+	c, err := api.NewClientWithResponses(ts.URL)
+	if err != nil {
+		t.Fatalf("client: %v", err)
+	}
+	return impl, ts, c
+}
+
+// anonymous opens an anonymous session and returns its access token.
+func anonymous(t *testing.T, c *api.ClientWithResponses) string {
+	t.Helper()
+	resp, err := c.PostSessionAnonymousWithResponse(context.Background(), api.AnonymousSessionRequest{})
+	if err != nil {
+		t.Fatalf("anonymous session: %v", err)
+	}
+	if resp.JSON201 == nil {
+		t.Fatalf("anonymous session: %s %s", resp.Status(), resp.Body)
+	}
+	return resp.JSON201.Token
+}
+
+// bearer authenticates one client call.
+func bearer(token string) api.RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	}
+}
+
+// wsURL is the chat socket address for token on the server at base.
+func wsURL(base, token string) string {
+	return "ws" + strings.TrimPrefix(base, "http") + "/ws/chat?token=" + url.QueryEscape(token)
+}
+
+// pair connects two fresh anonymous users and waits until they are paired.
+func pair(t *testing.T, ts *httptest.Server, c *api.ClientWithResponses) (a, b *websocket.Conn, conversationID string) {
+	t.Helper()
+	a = dial(t, ts, anonymous(t, c))
+	b = dial(t, ts, anonymous(t, c))
+	t.Cleanup(func() { a.Close(); b.Close() })
+	conversationID = next(t, a, api.Paired, 2*time.Second).ConversationId
+	next(t, b, api.Paired, 2*time.Second)
+	return a, b, conversationID
+}
+
+// dial opens the chat socket for token.
+func dial(t *testing.T, ts *httptest.Server, token string) *websocket.Conn {
+	t.Helper()
+	ws, _, err := websocket.DefaultDialer.Dial(wsURL(ts.URL, token), nil)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	return ws
+}
+
+// next reads events until one of type typ arrives or timeout passes.
+func next(t *testing.T, ws *websocket.Conn, typ api.ChatMessageType, timeout time.Duration) api.ChatMessage {
+	t.Helper()
+	_ = ws.SetReadDeadline(time.Now().Add(timeout))
+	defer ws.SetReadDeadline(time.Time{})
+	for {
+		var msg api.ChatMessage
+		if err := ws.ReadJSON(&msg); err != nil {
+			t.Fatalf("waiting for %s: %v", typ, err)
+		}
+		if msg.Type == typ {
+			return msg
+		}
+	}
+}
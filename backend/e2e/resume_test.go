@@ -0,0 +1,103 @@
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"backend/api"
+	"backend/config"
+)
+
+// TestResumeReplaysMissedMessages drops one side mid‑round and checks that
+// reconnecting within the grace window resumes the round and replays what
+// was sent meanwhile, in order.
+func TestResumeReplaysMissedMessages(t *testing.T) {
+	_, ts, c := newServer(t, func(cfg *config.Config) { cfg.Chat.ResumeGrace = 5 * time.Second })
+	ta := anonymous(t, c)
+	a, b := dial(t, ts, ta), dial(t, ts, anonymous(t, c))
+	defer func() { b.Close() }()
+	id := next(t, a, api.Paired, 2*time.Second).ConversationId
+	next(t, b, api.Paired, 2*time.Second)
+	a.Close()
+	time.Sleep(100 * time.Millisecond) // let the server notice the drop
+
+	for i := 1; i <= 3; i++ {
+		text := fmt.Sprintf("missed %d", i)
+		if err := b.WriteJSON(api.ChatMessage{Type: api.Chat, ConversationId: id, Message: &text}); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+		next(t, b, api.Ack, time.Second)
+	}
+
+	a = dial(t, ts, ta)
+	defer a.Close()
+	res := next(t, a, api.Resumed, 2*time.Second)
+	if res.ConversationId != id || res.ExpiresAt == nil {
+		t.Fatalf("resumed = %+v, want conversation %s with expiresAt", res, id)
+	}
+	var last int64
+	for i := 1; i <= 3; i++ {
+		m := next(t, a, api.Chat, time.Second)
+		if want := fmt.Sprintf("missed %d", i); m.Message == nil || *m.Message != want {
+			t.Fatalf("replay %d = %+v, want %q", i, m, want)
+		}
+		if m.Seq == nil || *m.Seq <= last {
+			t.Fatalf("replay %d seq = %v, want above %d", i, m.Seq, last)
+		}
+		last = *m.Seq
+	}
+}
+
+// TestReconnectAfterRoundEndedWithinGrace covers a socket that drops near
+// the end of a round: the round ends while the user is away, they come
+// back within the grace window and are paired again. The expiring grace
+// timer must leave the new round alone.
+func TestReconnectAfterRoundEndedWithinGrace(t *testing.T) {
+	const grace = 500 * time.Millisecond
+	_, ts, c := newServer(t, func(cfg *config.Config) {
+		cfg.Chat.ResumeGrace = grace
+		cfg.Chat.MatchWait = 0
+		cfg.Chat.RematchCooldown = 0
+	})
+	ta, tb := anonymous(t, c), anonymous(t, c)
+	a, b := dial(t, ts, ta), dial(t, ts, tb)
+	defer func() { a.Close(); b.Close() }()
+	next(t, a, api.Paired, 2*time.Second)
+	next(t, b, api.Paired, 2*time.Second)
+
+	a.Close()
+	time.Sleep(100 * time.Millisecond)
+	resp, err := c.PostSessionSkipWithResponse(context.Background(), bearer(tb))
+	if err != nil || resp.StatusCode() != 204 {
+		t.Fatalf("skip: %v %v", err, resp.Status())
+	}
+	next(t, b, api.Queued, time.Second)
+
+	a = dial(t, ts, ta)
+	id := next(t, a, api.Paired, 2*time.Second).ConversationId
+	next(t, b, api.Paired, 2*time.Second)
+	time.Sleep(grace + 300*time.Millisecond) // past the old grace window
+
+	text := "still here"
+	if err := a.WriteJSON(api.ChatMessage{Type: api.Chat, ConversationId: id, Message: &text}); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	_ = b.SetReadDeadline(time.Now().Add(2 * time.Second))
+	for {
+		var m api.ChatMessage
+		if err := b.ReadJSON(&m); err != nil {
+			t.Fatalf("waiting for chat: %v", err)
+		}
+		if m.Type == api.ConversationEnded || m.Type == api.MemberLeft {
+			t.Fatalf("partner got %s (%v) after the old grace window closed", m.Type, *m.Reason)
+		}
+		if m.Type == api.Chat {
+			break
+		}
+	}
+	if m := next(t, a, api.Chat, time.Second); *m.Message != text {
+		t.Fatalf("echo = %q", *m.Message)
+	}
+}
@@ -0,0 +1,112 @@
+package e2e
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"backend/api"
+)
+
+// session opens an anonymous session and returns its token pair.
+func session(t *testing.T, c *api.ClientWithResponses) (access, refresh string) {
+	t.Helper()
+	resp, err := c.PostSessionAnonymousWithResponse(context.Background(), api.AnonymousSessionRequest{})
+	if err != nil {
+		t.Fatalf("anonymous session: %v", err)
+	}
+	if resp.JSON201 == nil {
+		t.Fatalf("anonymous session: %s %s", resp.Status(), resp.Body)
+	}
+	return resp.JSON201.Token, resp.JSON201.RefreshToken
+}
+
+// refresh presents raw to /session/refresh.
+func refresh(t *testing.T, c *api.ClientWithResponses, raw string) *api.PostSessionRefreshResponse {
+	t.Helper()
+	resp, err := c.PostSessionRefreshWithResponse(context.Background(), api.RefreshRequest{RefreshToken: raw})
+	if err != nil {
+		t.Fatalf("refresh: %v", err)
+	}
+	return resp
+}
+
+// meStatus is the status GET /me answers for token.
+func meStatus(t *testing.T, c *api.ClientWithResponses, token string) int {
+	t.Helper()
+	resp, err := c.GetMeWithResponse(context.Background(), bearer(token))
+	if err != nil {
+		t.Fatalf("GET /me: %v", err)
+	}
+	return resp.StatusCode()
+}
+
+func TestRefreshReuseRevokesFamily(t *testing.T) {
+	_, _, c := newServer(t, nil)
+	_, first := session(t, c)
+
+	rotated := refresh(t, c, first)
+	if rotated.JSON200 == nil {
+		t.Fatalf("first refresh: %s %s", rotated.Status(), rotated.Body)
+	}
+	if meStatus(t, c, rotated.JSON200.Token) != http.StatusOK {
+		t.Fatalf("rotated access token rejected")
+	}
+
+	// the old token again looks like theft
+	reused := refresh(t, c, first)
+	if reused.StatusCode() != http.StatusUnauthorized {
+		t.Fatalf("reuse: status %d, want 401", reused.StatusCode())
+	}
+	if reused.JSON401 == nil || reused.JSON401.Error != "refresh_token_reused" {
+		t.Fatalf("reuse: body %s, want refresh_token_reused", reused.Body)
+	}
+
+	// and takes the rest of the family with it
+	if got := refresh(t, c, rotated.JSON200.RefreshToken).StatusCode(); got != http.StatusUnauthorized {
+		t.Fatalf("successor refresh token: status %d, want 401", got)
+	}
+	if got := meStatus(t, c, rotated.JSON200.Token); got != http.StatusUnauthorized {
+		t.Fatalf("successor access token: status %d, want 401", got)
+	}
+}
+
+func TestLogoutRevokesAccessAndRefreshTokens(t *testing.T) {
+	_, _, c := newServer(t, nil)
+	access, raw := session(t, c)
+	if meStatus(t, c, access) != http.StatusOK {
+		t.Fatalf("fresh access token rejected")
+	}
+
+	resp, err := c.PostLogoutWithResponse(context.Background(), api.RefreshRequest{RefreshToken: raw}, bearer(access))
+	if err != nil {
+		t.Fatalf("logout: %v", err)
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		t.Fatalf("logout: status %d, want 204", resp.StatusCode())
+	}
+
+	if got := meStatus(t, c, access); got != http.StatusUnauthorized {
+		t.Fatalf("access token after logout: status %d, want 401", got)
+	}
+	if got := refresh(t, c, raw).StatusCode(); got != http.StatusUnauthorized {
+		t.Fatalf("refresh token after logout: status %d, want 401", got)
+	}
+}
+
+func TestLogoutRevokesPresentedAccessTokenAlone(t *testing.T) {
+	_, _, c := newServer(t, nil)
+	access, _ := session(t, c)
+
+	// an unknown refresh token still logs out the bearer's access token
+	resp, err := c.PostLogoutWithResponse(context.Background(), api.RefreshRequest{RefreshToken: "unknown"}, bearer(access))
+	if err != nil {
+		t.Fatalf("logout: %v", err)
+	}
+	if resp.StatusCode() != http.StatusNoContent {
+		t.Fatalf("logout: status %d, want 204", resp.StatusCode())
+	}
+	if got := meStatus(t, c, access); got != http.StatusUnauthorized {
+		t.Fatalf("access token after logout: status %d, want 401", got)
+	}
+}
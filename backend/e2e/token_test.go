@@ -0,0 +1,112 @@
+package e2e
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/gorilla/websocket"
+
+	"backend/config"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+// withKey signs tokens with a known HS256 key so tests can forge them.
+func withKey(cfg *config.Config) {
+	cfg.Auth.JWT.ActiveKid = "test"
+	cfg.Auth.JWT.Keys = []config.KeyConfig{{ID: "test", Algorithm: "HS256", Secret: testSecret}}
+}
+
+func TestRejectedTokens(t *testing.T) {
+	_, ts, c := newServer(t, withKey)
+	access, raw := session(t, c)
+
+	// the claims of a real session, to vary one thing at a time
+	base := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(access, base); err != nil {
+		t.Fatalf("parse own token: %v", err)
+	}
+	claims := func(edit func(jwt.MapClaims)) jwt.MapClaims {
+		cl := jwt.MapClaims{}
+		for k, v := range base {
+			cl[k] = v
+		}
+		if edit != nil {
+			edit(cl)
+		}
+		return cl
+	}
+	sign := func(method jwt.SigningMethod, key any, cl jwt.MapClaims, header map[string]any) string {
+		tok := jwt.NewWithClaims(method, cl)
+		tok.Header["kid"] = "test"
+		for k, v := range header {
+			tok.Header[k] = v
+		}
+		s, err := tok.SignedString(key)
+		if err != nil {
+			t.Fatalf("sign: %v", err)
+		}
+		return s
+	}
+	hs256 := func(cl jwt.MapClaims) string { return sign(jwt.SigningMethodHS256, []byte(testSecret), cl, nil) }
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("rsa key: %v", err)
+	}
+	past := time.Now().Add(-time.Hour).Unix()
+
+	// the forging above is sound: an untouched copy is accepted
+	if got := meStatus(t, c, hs256(claims(nil))); got != http.StatusOK {
+		t.Fatalf("re-signed own claims: status %d, want 200", got)
+	}
+
+	cases := []struct {
+		name  string
+		token string
+	}{
+		{"garbage", "not-a-jwt"},
+		{"two segments", "abc.def"},
+		{"bad base64", "!!!.???.***"},
+		{"truncated signature", access[:len(access)-4]},
+		{"refresh token as access token", raw},
+		{"expired", hs256(claims(func(cl jwt.MapClaims) { cl["exp"] = past }))},
+		{"issued in the future", hs256(claims(func(cl jwt.MapClaims) { cl["iat"] = time.Now().Add(time.Hour).Unix() }))},
+		{"missing exp", hs256(claims(func(cl jwt.MapClaims) { delete(cl, "exp") }))},
+		{"missing sub", hs256(claims(func(cl jwt.MapClaims) { delete(cl, "sub") }))},
+		{"unknown sub", hs256(claims(func(cl jwt.MapClaims) { cl["sub"] = "nobody" }))},
+		{"missing jti", hs256(claims(func(cl jwt.MapClaims) { delete(cl, "jti") }))},
+		{"wrong issuer", hs256(claims(func(cl jwt.MapClaims) { cl["iss"] = "someone-else" }))},
+		{"wrong audience", hs256(claims(func(cl jwt.MapClaims) { cl["aud"] = "someone-else" }))},
+		{"wrong typ", sign(jwt.SigningMethodHS256, []byte(testSecret), claims(nil), map[string]any{"typ": "refresh+jwt"})},
+		{"unknown kid", sign(jwt.SigningMethodHS256, []byte(testSecret), claims(nil), map[string]any{"kid": "other"})},
+		{"wrong secret", sign(jwt.SigningMethodHS256, []byte(strings.Repeat("x", 32)), claims(nil), nil)},
+		{"alg none", sign(jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, claims(nil), nil)},
+		{"HS512", sign(jwt.SigningMethodHS512, []byte(testSecret), claims(nil), nil)},
+		{"RS256", sign(jwt.SigningMethodRS256, rsaKey, claims(nil), nil)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := meStatus(t, c, tc.token); got != http.StatusUnauthorized {
+				t.Fatalf("GET /me: status %d, want 401", got)
+			}
+			ws, resp, err := websocket.DefaultDialer.Dial(wsURL(ts.URL, tc.token), nil)
+			if err == nil {
+				ws.Close()
+				t.Fatalf("/ws/chat: upgraded, want 401")
+			}
+			if resp == nil || resp.StatusCode != http.StatusUnauthorized {
+				t.Fatalf("/ws/chat: %v, want 401", err)
+			}
+		})
+	}
+
+	// none of it hurt the server
+	if got := meStatus(t, c, access); got != http.StatusOK {
+		t.Fatalf("valid token after the above: status %d, want 200", got)
+	}
+}
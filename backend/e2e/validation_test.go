@@ -0,0 +1,100 @@
+package e2e
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"backend/api"
+	"backend/config"
+)
+
+func limits(cfg *config.Config) {
+	cfg.Chat.MaxMessageLength = 10
+	cfg.WS.MaxFrameBytes = 2048
+}
+
+func TestInboundFrameValidation(t *testing.T) {
+	_, ts, c := newServer(t, limits)
+	a, b, conv := pair(t, ts, c)
+
+	chat := func(text string) []byte {
+		raw, _ := json.Marshal(api.ChatMessage{Type: api.Chat, ConversationId: conv, Message: &text})
+		return raw
+	}
+	cases := []struct {
+		name  string
+		kind  int
+		frame []byte
+		code  api.ChatMessageCode
+	}{
+		{"malformed JSON", websocket.TextMessage, []byte(`{"type":`), api.BadPayload},
+		{"binary frame", websocket.BinaryMessage, chat("hi"), api.BadPayload},
+		{"invalid UTF-8", websocket.TextMessage,
+			[]byte(`{"type":"chat","conversationId":"` + conv + "\",\"message\":\"h\xffi\"}"), api.BadPayload},
+		{"control character", websocket.TextMessage, chat("bell\a"), api.BadPayload},
+		{"empty message", websocket.TextMessage, chat(""), api.BadPayload},
+		{"blank message", websocket.TextMessage, chat(" \n\t "), api.BadPayload},
+		{"missing message", websocket.TextMessage,
+			[]byte(`{"type":"chat","conversationId":"` + conv + `"}`), api.BadPayload},
+		{"message too long", websocket.TextMessage, chat(strings.Repeat("é", 11)), api.MessageTooLarge},
+		{"server-only type", websocket.TextMessage,
+			[]byte(`{"type":"time_up","conversationId":"` + conv + `"}`), api.BadPayload},
+		{"foreign conversation", websocket.TextMessage,
+			[]byte(`{"type":"chat","conversationId":"someone-else","message":"hi"}`), api.NotInConversation},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := a.WriteMessage(tc.kind, tc.frame); err != nil {
+				t.Fatalf("write: %v", err)
+			}
+			ev := next(t, a, api.ErrorEvent, 2*time.Second)
+			if ev.Code == nil || *ev.Code != tc.code {
+				t.Fatalf("code = %v, want %s", ev.Code, tc.code)
+			}
+		})
+	}
+
+	// none of the above reached the partner, the socket still works, and a
+	// message of exactly the maximum length (newline and tab allowed) passes
+	if err := a.WriteMessage(websocket.TextMessage, chat("ok\nfine\t!")); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	got := next(t, b, api.Chat, 2*time.Second)
+	if got.Message == nil || *got.Message != "ok\nfine\t!" {
+		t.Fatalf("partner got %v, want the valid message", got.Message)
+	}
+}
+
+func TestOversizedFrameClosesWith1009(t *testing.T) {
+	_, ts, c := newServer(t, limits)
+	a, b, conv := pair(t, ts, c)
+
+	huge := strings.Repeat("x", 4096)
+	if err := a.WriteJSON(api.ChatMessage{Type: api.Chat, ConversationId: conv, Message: &huge}); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	_ = a.SetReadDeadline(time.Now().Add(2 * time.Second))
+	for {
+		_, _, err := a.ReadMessage()
+		if err == nil {
+			continue
+		}
+		var ce *websocket.CloseError
+		if !errors.As(err, &ce) || ce.Code != websocket.CloseMessageTooBig {
+			t.Fatalf("read error = %v, want close 1009", err)
+		}
+		break
+	}
+
+	// the partner never saw the frame
+	_ = b.SetReadDeadline(time.Now().Add(200 * time.Millisecond))
+	var msg api.ChatMessage
+	if err := b.ReadJSON(&msg); err == nil && msg.Type == api.Chat {
+		t.Fatalf("partner received the oversized message")
+	}
+}
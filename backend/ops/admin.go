@@ -0,0 +1,208 @@
+// backend/ops/admin.go
+// The operator API under /admin: who is connected, who is waiting, which
+// rounds are running and how long they have left, plus a few levers — end
+// a round, disconnect a user, broadcast a notice. It is guarded by a
+// separate credential (admin.token) rather than user JWTs, and is switched
+// off when none is configured. Every intervention is logged.
+
+package ops
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"log/slog"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"backend/api"
+)
+
+const maxNoticeLength = 500 // characters
+
+// admin reports whether r carries the operator token, answering it if not.
+// With no token configured the API answers 404 so it does not advertise
+// itself.
+func (s *Server) admin(w http.ResponseWriter, r *http.Request) bool {
+	if s.cfg.Admin.Token == "" {
+		writeError(w, http.StatusNotFound, "not_found", "")
+		return false
+	}
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(s.cfg.Admin.Token)) != 1 {
+		slog.Warn("Rejected admin request", "path", r.URL.Path, "remoteAddr", r.RemoteAddr)
+		writeError(w, http.StatusUnauthorized, "invalid_token", "")
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// GET /admin/users
+func (s *Server) GetAdminUsers(w http.ResponseWriter, r *http.Request) {
+	slog.Info("Handling GET /admin/users")
+	if !s.admin(w, r) {
+		return
+	}
+	s.mu.RLock()
+	convOf := map[*user]string{}
+	for _, c := range s.store.Conversations() {
+		for _, p := range c.Participants {
+			convOf[p] = c.ID
+		}
+	}
+	queued := map[*user]bool{}
+	for _, u := range s.store.Queue() {
+		queued[u] = true
+	}
+	out := make([]api.AdminUser, 0, len(s.connected))
+	for _, u := range s.connected {
+		au := api.AdminUser{Id: u.ID, Registered: u.registered(), Queued: queued[u]}
+		if u.Username != "" {
+			username := u.Username
+			au.Username = &username
+		}
+		if id, ok := convOf[u]; ok {
+			au.ConversationId = &id
+		}
+		out = append(out, au)
+	}
+	s.mu.RUnlock()
+	writeJSON(w, out)
+}
+
+// GET /admin/queue
+func (s *Server) GetAdminQueue(w http.ResponseWriter, r *http.Request) {
+	slog.Info("Handling GET /admin/queue")
+	if !s.admin(w, r) {
+		return
+	}
+	now := time.Now()
+	s.mu.RLock()
+	v := s.viewQueue(now)
+	out := make([]api.AdminQueueEntry, 0, len(v.queue))
+	for i, u := range v.queue {
+		e := api.AdminQueueEntry{
+			Id:             u.ID,
+			Position:       i + 1,
+			Connected:      u.conn != nil,
+			WaitingSeconds: int(now.Sub(u.queuedAt).Seconds()),
+		}
+		if len(u.interests) > 0 {
+			interests := append([]string(nil), u.interests...)
+			e.Interests = &interests
+		}
+		if u.language != "" {
+			language := u.language
+			e.Language = &language
+		}
+		out = append(out, e)
+	}
+	s.mu.RUnlock()
+	writeJSON(w, out)
+}
+
+// GET /admin/conversations
+func (s *Server) GetAdminConversations(w http.ResponseWriter, r *http.Request) {
+	slog.Info("Handling GET /admin/conversations")
+	if !s.admin(w, r) {
+		return
+	}
+	now := time.Now()
+	s.mu.RLock()
+	convs := s.store.Conversations()
+	out := make([]api.AdminConversation, 0, len(convs))
+	for _, c := range convs {
+		out = append(out, api.AdminConversation{
+			Id:               c.ID,
+			Members:          c.members(),
+			ExpiresAt:        c.expiresAt.UTC(),
+			RemainingSeconds: int(math.Max(0, math.Ceil(c.expiresAt.Sub(now).Seconds()))),
+			Extensions:       c.extensions,
+		})
+	}
+	s.mu.RUnlock()
+	writeJSON(w, out)
+}
+
+// POST /admin/conversations/{id}/end
+func (s *Server) PostAdminConversationsIdEnd(w http.ResponseWriter, r *http.Request, id string) {
+	slog.Info("Handling POST /admin/conversations/{id}/end", "conversationID", id)
+	if !s.admin(w, r) {
+		return
+	}
+	s.mu.Lock()
+	conv := s.store.Conversation(id)
+	if conv == nil {
+		s.mu.Unlock()
+		writeError(w, http.StatusNotFound, "conversation_not_found", "")
+		return
+	}
+	s.endConversation(conv, api.ReasonModeratorAction)
+	s.enqueue(conv.Participants...)
+	s.mu.Unlock()
+	s.tryPair()
+	w.WriteHeader(http.StatusNoContent)
+	slog.Warn("Admin ended conversation", "conversationID", id, "remoteAddr", r.RemoteAddr)
+}
+
+// POST /admin/users/{id}/disconnect
+func (s *Server) PostAdminUsersIdDisconnect(w http.ResponseWriter, r *http.Request, id string) {
+	slog.Info("Handling POST /admin/users/{id}/disconnect", "userID", id)
+	if !s.admin(w, r) {
+		return
+	}
+	s.mu.Lock()
+	u := s.connected[id]
+	if u == nil {
+		s.mu.Unlock()
+		writeError(w, http.StatusNotFound, "user_not_connected", "")
+		return
+	}
+	s.leaveConversations(u, api.ReasonModeratorAction)
+	s.store.Dequeue(u)
+	// the reader notices the close and cleans up as for any dropped socket
+	u.conn.close(websocket.ClosePolicyViolation, "disconnected by moderator")
+	s.mu.Unlock()
+	s.tryPair()
+	w.WriteHeader(http.StatusNoContent)
+	slog.Warn("Admin disconnected user", "userID", id, "remoteAddr", r.RemoteAddr)
+}
+
+// POST /admin/broadcast
+func (s *Server) PostAdminBroadcast(w http.ResponseWriter, r *http.Request) {
+	slog.Info("Handling POST /admin/broadcast")
+	if !s.admin(w, r) {
+		return
+	}
+	var req api.BroadcastRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Error("Failed to decode request", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	text := strings.TrimSpace(req.Message)
+	if text == "" || len([]rune(text)) > maxNoticeLength {
+		writeError(w, http.StatusBadRequest, "invalid_message", "")
+		return
+	}
+	now := time.Now().UTC()
+	notice := api.ChatMessage{Type: api.Notice, Message: &text, Timestamp: &now}
+	sent := 0
+	s.mu.RLock()
+	for _, u := range s.connected {
+		if u.conn.send(notice) {
+			sent++
+		}
+	}
+	s.mu.RUnlock()
+	writeJSON(w, api.BroadcastResult{Recipients: sent})
+	slog.Warn("Admin broadcast", "recipients", sent, "message", text, "remoteAddr", r.RemoteAddr)
+}
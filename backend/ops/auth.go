@@ -0,0 +1,63 @@
+// backend/ops/auth.go
+// Password hashing and policy for registered accounts.
+
+package ops
+
+import (
+	"crypto/rand"
+	"errors"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	minPasswordLen = 8
+	maxPasswordLen = 72 // bcrypt silently ignores anything past 72 bytes
+)
+
+var (
+	errPasswordTooShort   = errors.New("password must be at least 8 characters")
+	errPasswordTooLong    = errors.New("password must be at most 72 bytes")
+	errPasswordIsUsername = errors.New("password must not match the username")
+)
+
+// dummyHash is compared against when a login names an unknown account, so a
+// miss costs as much bcrypt work as a wrong password.
+var dummyHash = func() []byte {
+	pw := make([]byte, 32)
+	_, _ = rand.Read(pw)
+	h, err := bcrypt.GenerateFromPassword(pw, bcrypt.DefaultCost)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}()
+
+// checkPasswordPolicy enforces the minimum rules for a new password.
+func checkPasswordPolicy(username, password string) error {
+	switch {
+	case len([]rune(password)) < minPasswordLen:
+		return errPasswordTooShort
+	case len(password) > maxPasswordLen:
+		return errPasswordTooLong
+	case strings.EqualFold(strings.TrimSpace(password), username):
+		return errPasswordIsUsername
+	}
+	return nil
+}
+
+func hashPassword(password string) ([]byte, error) {
+	return bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+}
+
+// verifyPassword reports whether password matches hash. A nil or empty hash
+// (unknown user, or an account created before passwords existed) is checked
+// against dummyHash and always fails.
+func verifyPassword(hash []byte, password string) bool {
+	if len(hash) == 0 {
+		_ = bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+		return false
+	}
+	return bcrypt.CompareHashAndPassword(hash, []byte(password)) == nil
+}
@@ -0,0 +1,151 @@
+// backend/ops/client.go
+// One WebSocket connection. gorilla/websocket allows a single concurrent
+// writer, so every server→client event is queued on the client's bounded
+// outbox and written by its own writePump goroutine. A client whose outbox
+// overflows is too slow to keep up and gets disconnected. The writer also
+// pings; the reader (GetWsChat) pushes its deadline out on every frame or
+// pong, so a peer that silently vanished is noticed within the idle timeout.
+
+package ops
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"backend/api"
+	"backend/config"
+)
+
+// frame is one outbox entry: an event, or (closeCode != 0) a close frame
+// after which the writer stops. written, if set, runs on the writer
+// goroutine once the event has been handed to the socket.
+type frame struct {
+	msg       api.ChatMessage
+	written   func()
+	closeCode int
+	closeText string
+}
+
+type client struct {
+	conn         *websocket.Conn
+	userID       string
+	out          chan frame
+	done         chan struct{} // closed by stop; the writer exits
+	stopOnce     sync.Once
+	writeTimeout time.Duration
+	pingInterval time.Duration
+	idleTimeout  time.Duration
+}
+
+func newClient(conn *websocket.Conn, userID string, cfg config.WSConfig) *client {
+	c := &client{
+		conn:         conn,
+		userID:       userID,
+		out:          make(chan frame, cfg.SendQueue),
+		done:         make(chan struct{}),
+		writeTimeout: cfg.WriteTimeout,
+		pingInterval: cfg.PingInterval,
+		idleTimeout:  cfg.IdleTimeout,
+	}
+	c.keepAlive()
+	conn.SetPongHandler(func(string) error {
+		c.keepAlive()
+		return nil
+	})
+	return c
+}
+
+// keepAlive gives the peer another idle timeout. Only the reader goroutine
+// calls it (pong handlers run inside ReadMessage).
+func (c *client) keepAlive() {
+	_ = c.conn.SetReadDeadline(time.Now().Add(c.idleTimeout))
+}
+
+// send queues msg without blocking. It returns false if the client is gone
+// or was just disconnected for overflowing its outbox.
+func (c *client) send(msg api.ChatMessage) bool {
+	return c.sendThen(msg, nil)
+}
+
+// sendThen is send with a callback for when the frame has been written.
+func (c *client) sendThen(msg api.ChatMessage, written func()) bool {
+	select {
+	case <-c.done:
+		return false
+	default:
+	}
+	select {
+	case c.out <- frame{msg: msg, written: written}:
+		return true
+	default:
+		slog.Warn("Outbox full; disconnecting slow consumer", "userID", c.userID, "queued", len(c.out))
+		c.kill(websocket.ClosePolicyViolation, "send queue overflow")
+		return false
+	}
+}
+
+// close queues a close frame behind any pending events, so e.g. a
+// server_restarting notice is delivered before the socket goes away.
+func (c *client) close(code int, text string) {
+	select {
+	case c.out <- frame{closeCode: code, closeText: text}:
+	case <-c.done:
+	default:
+		c.kill(code, text)
+	}
+}
+
+// kill skips the queue: WriteControl may run concurrently with the writer.
+// It returns at once; the caller is usually some other user's reader, which
+// must not stall behind this client's full socket buffer.
+func (c *client) kill(code int, text string) {
+	c.stop()
+	go func() {
+		_ = c.conn.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(code, text), time.Now().Add(c.writeTimeout))
+		_ = c.conn.Close()
+	}()
+}
+
+// stop ends the writer; safe to call more than once.
+func (c *client) stop() {
+	c.stopOnce.Do(func() { close(c.done) })
+}
+
+func (c *client) writePump() {
+	ping := time.NewTicker(c.pingInterval)
+	defer ping.Stop()
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-ping.C:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(c.writeTimeout))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				slog.Info("WebSocket ping failed", "userID", c.userID, "error", err)
+				c.stop()
+				_ = c.conn.Close()
+				return
+			}
+		case f := <-c.out:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(c.writeTimeout))
+			if f.closeCode != 0 {
+				_ = c.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(f.closeCode, f.closeText))
+				c.stop()
+				return
+			}
+			if err := c.conn.WriteJSON(f.msg); err != nil {
+				slog.Warn("WebSocket write failed", "userID", c.userID, "type", f.msg.Type, "error", err)
+				c.stop()
+				_ = c.conn.Close() // unblocks the reader, which runs the cleanup
+				return
+			}
+			if f.written != nil {
+				f.written()
+			}
+		}
+	}
+}
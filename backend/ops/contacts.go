@@ -0,0 +1,103 @@
+// backend/ops/contacts.go
+// Turning a partner into a contact. connect is a vote like extend_request:
+// it is relayed to the partner, and once every participant has sent it the
+// pair is stored as contacts and each side learns the other's username.
+// Only registered users have a username to reveal and an account to keep
+// the contact on, so anonymous senders are told to register first.
+
+package ops
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"backend/api"
+)
+
+// registered reports whether u has an account. The caller holds s.mu.
+func (u *user) registered() bool { return len(u.passwordHash) > 0 }
+
+// voteConnect records u's connect in conv. The caller holds s.mu.
+func (s *Server) voteConnect(conv *conversation, u *user, msg api.ChatMessage) {
+	if !u.registered() {
+		u.conn.send(*errorEvent(conv.ID, api.RegistrationRequired, "register an account to connect with your partner"))
+		return
+	}
+	if conv.connectVotes == nil {
+		conv.connectVotes = map[*user]bool{}
+	}
+	if conv.connectVotes[u] {
+		return
+	}
+	conv.connectVotes[u] = true
+	for _, p := range conv.Participants {
+		if p != u && p.conn != nil {
+			p.conn.send(msg)
+		}
+	}
+	if conv.unanimous(conv.connectVotes) {
+		s.connect(conv)
+	}
+}
+
+// connect stores every pair in conv as contacts and tells each participant
+// who the others are. The caller holds s.mu.
+func (s *Server) connect(conv *conversation) {
+	conv.connectVotes = nil
+	now := time.Now().UTC()
+	ps := conv.Participants
+	for i, a := range ps {
+		for _, b := range ps[i+1:] {
+			err := s.store.AddContact(
+				contact{UserID: a.ID, Username: a.Username, Since: now},
+				contact{UserID: b.ID, Username: b.Username, Since: now})
+			if err != nil {
+				slog.Error("Failed to save contact", "conversationID", conv.ID, "error", err)
+				continue
+			}
+			for _, pair := range [][2]*user{{a, b}, {b, a}} {
+				if to := pair[0].conn; to != nil {
+					to.send(api.ChatMessage{
+						Type:           api.ContactAdded,
+						ConversationId: conv.ID,
+						Timestamp:      &now,
+						Contact:        &api.User{Id: pair[1].ID, Username: pair[1].Username},
+					})
+				}
+			}
+			slog.Info("Contact added", "conversationID", conv.ID, "userID", a.ID, "contactID", b.ID)
+		}
+	}
+}
+
+// GET /contacts
+func (s *Server) GetContacts(w http.ResponseWriter, r *http.Request) {
+	slog.Info("Handling GET /contacts")
+	bearer := r.Header.Get("Authorization")
+	if !strings.HasPrefix(bearer, "Bearer ") {
+		slog.Warn("Missing token in request")
+		writeError(w, http.StatusUnauthorized, "missing_token", "")
+		return
+	}
+	u, err := s.userFromJWT(strings.TrimPrefix(bearer, "Bearer "))
+	if err != nil || u == nil {
+		slog.Warn("Invalid token", "error", err)
+		writeError(w, http.StatusUnauthorized, "invalid_token", "")
+		return
+	}
+	cs, err := s.store.Contacts(u.ID)
+	if err != nil {
+		slog.Error("Failed to list contacts", "userID", u.ID, "error", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	out := make([]api.Contact, 0, len(cs))
+	for _, c := range cs {
+		out = append(out, api.Contact{Id: c.UserID, Username: c.Username, Since: c.Since.UTC()})
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	_ = json.NewEncoder(w).Encode(out)
+}
@@ -0,0 +1,74 @@
+// backend/ops/extend.go
+// Extending a round by mutual agreement. extend_request and extend_accept
+// are both votes; the request is relayed so the partner can accept. When
+// every participant has voted, the round timer is pushed out by
+// chat.extendBy and everyone gets an extended event with the new end.
+
+package ops
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"backend/api"
+)
+
+// voteExtend records u's vote in conv. The caller holds s.mu.
+func (s *Server) voteExtend(conv *conversation, u *user, msg api.ChatMessage) {
+	if conv.extensions >= s.cfg.Chat.MaxExtensions {
+		u.conn.send(*errorEvent(conv.ID, api.ExtensionLimit,
+			fmt.Sprintf("a round can be extended at most %d times", s.cfg.Chat.MaxExtensions)))
+		return
+	}
+	if conv.extendVotes == nil {
+		conv.extendVotes = map[*user]bool{}
+	}
+	conv.extendVotes[u] = true
+	if !conv.unanimous(conv.extendVotes) {
+		// still waiting on someone; make sure they know there is a vote
+		for _, q := range conv.Participants {
+			if q != u && q.conn != nil {
+				q.conn.send(msg)
+			}
+		}
+		return
+	}
+	s.extend(conv)
+}
+
+// unanimous reports whether every participant of c has voted in votes. The
+// caller holds Server.mu.
+func (c *conversation) unanimous(votes map[*user]bool) bool {
+	for _, p := range c.Participants {
+		if !votes[p] {
+			return false
+		}
+	}
+	return true
+}
+
+// extend pushes conv's end out by chat.extendBy. The caller holds s.mu.
+func (s *Server) extend(conv *conversation) {
+	if !conv.timer.Stop() {
+		return // the round is already ending; its callback is waiting on s.mu
+	}
+	conv.extendVotes = nil
+	conv.extensions++
+	conv.expiresAt = conv.expiresAt.Add(s.cfg.Chat.ExtendBy)
+	conv.timer.Reset(time.Until(conv.expiresAt))
+	if err := s.store.SaveConversation(conv); err != nil {
+		slog.Error("Failed to save conversation", "conversationID", conv.ID, "error", err)
+	}
+	now := time.Now().UTC()
+	expiresAt := conv.expiresAt
+	for _, c := range clientsOf(conv) {
+		c.send(api.ChatMessage{
+			Type:           api.Extended,
+			ConversationId: conv.ID,
+			Timestamp:      &now,
+			ExpiresAt:      &expiresAt,
+		})
+	}
+	slog.Info("Conversation extended", "conversationID", conv.ID, "extensions", conv.extensions, "expiresAt", expiresAt)
+}
@@ -0,0 +1,230 @@
+// backend/ops/filters.go
+// Chat moderation. Every chat message passes an ordered chain of filters
+// (moderation.filters) before it is numbered and relayed. Each filter
+// allows the text, rewrites or redacts it for the filters after it, or drops
+// the message, which ends the chain; the sender then gets an error event
+// with code message_dropped. Every decision is logged at info with the
+// message length; the original text of anything a filter changed or dropped
+// is private chat and only goes to the debug log. Text a rewrite pushed past
+// chat.maxMessageLength is cut back to it.
+
+package ops
+
+import (
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"backend/api"
+	"backend/config"
+)
+
+// action is what a filter decided to do with a message.
+type action string
+
+const (
+	allow   action = "allow"
+	rewrite action = "rewrite" // reworded, e.g. a link replaced by a note
+	redact  action = "redact"  // parts masked out
+	drop    action = "drop"
+)
+
+type verdict struct {
+	action action
+	text   string // the new text, for rewrite and redact
+	reason string // why, for anything but allow
+}
+
+// messageFilter is one link of the chain. from is the sender; check runs
+// with Server.mu held and must not block.
+type messageFilter interface {
+	name() string
+	check(from *user, text string) verdict
+}
+
+// newFilters builds the chain named in cfg, in order.
+func newFilters(cfg config.ModerationConfig) ([]messageFilter, error) {
+	var chain []messageFilter
+	for _, name := range cfg.Filters {
+		switch name {
+		case "wordList":
+			chain = append(chain, newWordFilter(cfg.BlockedWords))
+		case "contactInfo":
+			chain = append(chain, contactFilter{})
+		case "repeats":
+			chain = append(chain, repeatFilter{max: cfg.MaxRepeat})
+		default:
+			return nil, fmt.Errorf("unknown message filter %q", name)
+		}
+	}
+	return chain, nil
+}
+
+// moderate runs msg through the chain. It returns false if the message was
+// dropped, after telling the sender; otherwise msg.Message holds the text to
+// relay. The caller holds s.mu.
+func (s *Server) moderate(conv *conversation, from *user, msg *api.ChatMessage) bool {
+	original := *msg.Message
+	text := original
+	for _, f := range s.filters {
+		v := f.check(from, text)
+		logDecision(conv, from, f.name(), v.action, v.reason, original)
+		if v.action == allow {
+			continue
+		}
+		if v.action == drop {
+			notice := errorEvent(conv.ID, api.MessageDropped, v.reason)
+			notice.ClientMsgId = msg.ClientMsgId
+			from.conn.send(*notice)
+			return false
+		}
+		text = v.text
+	}
+	// a rewrite may have grown the text past what decodeFrame let through
+	if max := s.cfg.Chat.MaxMessageLength; utf8.RuneCountInString(text) > max {
+		text = string([]rune(text)[:max])
+		logDecision(conv, from, "length", rewrite, "longer than chat.maxMessageLength after filtering", original)
+	}
+	msg.Message = &text
+	return true
+}
+
+// logDecision records what filter decided about from's message. original is
+// only logged at debug.
+func logDecision(conv *conversation, from *user, filter string, act action, reason, original string) {
+	slog.Info("Message filter", "filter", filter, "action", act, "reason", reason,
+		"userID", from.ID, "conversationID", conv.ID, "length", utf8.RuneCountInString(original))
+	if act != allow {
+		slog.Debug("Message filter original", "filter", filter, "conversationID", conv.ID, "original", original)
+	}
+}
+
+// ─── FILTERS ───────────────────────────────────────────────────────────────
+
+// wordFilter masks configured words with asterisks. Words are matched whole
+// and case‑insensitively in any script; \b would only see ASCII letters.
+type wordFilter struct {
+	re *regexp.Regexp // nil without words; group 2 is the word
+}
+
+func newWordFilter(words []string) wordFilter {
+	var alts []string
+	for _, w := range words {
+		if w = strings.TrimSpace(w); w != "" {
+			alts = append(alts, regexp.QuoteMeta(w))
+		}
+	}
+	if len(alts) == 0 {
+		return wordFilter{}
+	}
+	return wordFilter{re: regexp.MustCompile(`(?i)(^|[^\p{L}\p{N}_])(` + strings.Join(alts, "|") + `)($|[^\p{L}\p{N}_])`)}
+}
+
+func (wordFilter) name() string { return "wordList" }
+
+func (f wordFilter) check(_ *user, text string) verdict {
+	if f.re == nil || !f.re.MatchString(text) {
+		return verdict{action: allow}
+	}
+	// a match swallows the delimiter after it, so in "x x" the second x
+	// only matches on the next pass
+	masked := text
+	for {
+		next := f.mask(masked)
+		if next == masked {
+			break
+		}
+		masked = next
+	}
+	return verdict{action: redact, text: masked, reason: "blocked word"}
+}
+
+// mask stars out every word f.re finds in one pass, keeping the delimiters.
+func (f wordFilter) mask(text string) string {
+	var b strings.Builder
+	last := 0
+	for _, m := range f.re.FindAllStringSubmatchIndex(text, -1) {
+		b.WriteString(text[last:m[4]])
+		b.WriteString(strings.Repeat("*", utf8.RuneCountInString(text[m[4]:m[5]])))
+		last = m[5]
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+// contactFilter keeps anonymous users from swapping email addresses, links
+// and phone numbers; registered users are trusted with them.
+type contactFilter struct{}
+
+var (
+	emailPattern = regexp.MustCompile(`(?i)[a-z0-9._%+-]+@[a-z0-9-]+(?:\.[a-z0-9-]+)+`)
+	linkPattern  = regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S+|\b[a-z0-9-]+(?:\.[a-z0-9-]+)*\.(?:com|net|org|io|me|gg|co|app|dev|xyz|info|ly)\b(?:/\S*)?`)
+	phonePattern = regexp.MustCompile(`\+?\d[\d\s().-]{5,}\d`)
+)
+
+func (contactFilter) name() string { return "contactInfo" }
+
+func (contactFilter) check(from *user, text string) verdict {
+	if from.registered() {
+		return verdict{action: allow}
+	}
+	out := emailPattern.ReplaceAllString(text, "[email removed]")
+	out = linkPattern.ReplaceAllString(out, "[link removed]")
+	out = phonePattern.ReplaceAllStringFunc(out, func(m string) string {
+		digits := 0
+		for _, r := range m {
+			if unicode.IsDigit(r) {
+				digits++
+			}
+		}
+		if digits < 7 {
+			return m // a year, a score, a small number
+		}
+		return "[number removed]"
+	})
+	if out == text {
+		return verdict{action: allow}
+	}
+	return verdict{action: rewrite, text: out, reason: "contact details from an anonymous user"}
+}
+
+// repeatFilter squeezes long runs of one character and drops messages
+// that are nothing else. Asterisks are left alone: they may be wordFilter's
+// masks.
+type repeatFilter struct {
+	max int
+}
+
+func (repeatFilter) name() string { return "repeats" }
+
+func (f repeatFilter) check(_ *user, text string) verdict {
+	var b strings.Builder
+	var prev rune
+	run, squeezed := 0, false
+	distinct := map[rune]bool{}
+	for _, r := range text {
+		if r == prev {
+			run++
+		} else {
+			prev, run = r, 1
+		}
+		if !unicode.IsSpace(r) && r != '*' {
+			distinct[r] = true
+		}
+		if run > f.max && r != '*' {
+			squeezed = true
+			continue
+		}
+		b.WriteRune(r)
+	}
+	if !squeezed {
+		return verdict{action: allow}
+	}
+	if len(distinct) == 1 {
+		return verdict{action: drop, reason: "repeated characters"}
+	}
+	return verdict{action: rewrite, text: b.String(), reason: "repeated characters"}
+}
@@ -0,0 +1,114 @@
+// backend/ops/inbound.go
+// Validation of frames read from a client. Clients may only send a handful
+// of event types, only into the conversation they are in, and only with the
+// fields that type carries; everything else the server fills in itself.
+// Rejected frames are answered with an error event to the sender alone, and
+// the connection stays open.
+
+package ops
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gorilla/websocket"
+
+	"backend/api"
+)
+
+// maxClientMsgID caps the client‑chosen message ID, in bytes.
+const maxClientMsgID = 64
+
+// clientTypes are the event types a client may send.
+var clientTypes = map[api.ChatMessageType]bool{
+	api.Chat:          true,
+	api.Typing:        true,
+	api.StoppedTyping: true,
+	api.Away:          true,
+	api.Back:          true,
+	api.Read:          true,
+	api.ExtendRequest: true,
+	api.ExtendAccept:  true,
+	api.Connect:       true,
+}
+
+// decodeFrame parses and checks one frame read from a client. If the frame
+// must be rejected, bad is the error event to answer with. Frames over
+// websocket.maxFrameBytes never get here; the read limit closes the socket.
+func (s *Server) decodeFrame(kind int, data []byte) (msg api.ChatMessage, bad *api.ChatMessage) {
+	if kind != websocket.TextMessage {
+		return msg, errorEvent("", api.BadPayload, "expected a text frame")
+	}
+	// encoding/json would quietly turn invalid UTF‑8 into U+FFFD
+	if !utf8.Valid(data) {
+		return msg, errorEvent("", api.BadPayload, "frame is not valid UTF-8")
+	}
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return msg, errorEvent("", api.BadPayload, "not a ChatMessage: "+err.Error())
+	}
+	if !clientTypes[msg.Type] {
+		return msg, errorEvent(msg.ConversationId, api.BadPayload, fmt.Sprintf("clients may not send %q events", msg.Type))
+	}
+	msg = sanitize(msg)
+	if msg.Type != api.Chat {
+		return msg, nil
+	}
+	if msg.ClientMsgId != nil && len(*msg.ClientMsgId) > maxClientMsgID {
+		return msg, errorEvent(msg.ConversationId, api.BadPayload, fmt.Sprintf("clientMsgId is longer than %d bytes", maxClientMsgID))
+	}
+	if msg.Message == nil || strings.TrimSpace(*msg.Message) == "" {
+		return msg, errorEvent(msg.ConversationId, api.BadPayload, "message is empty")
+	}
+	if max := s.cfg.Chat.MaxMessageLength; utf8.RuneCountInString(*msg.Message) > max {
+		return msg, errorEvent(msg.ConversationId, api.MessageTooLarge, fmt.Sprintf("at most %d characters", max))
+	}
+	if r, ok := controlChar(*msg.Message); ok {
+		return msg, errorEvent(msg.ConversationId, api.BadPayload, fmt.Sprintf("message contains control character %U", r))
+	}
+	return msg, nil
+}
+
+// controlChar finds the first control character other than newline and tab.
+func controlChar(text string) (rune, bool) {
+	for _, r := range text {
+		if r != '\n' && r != '\t' && unicode.IsControl(r) {
+			return r, true
+		}
+	}
+	return 0, false
+}
+
+// sanitize keeps only what a client may set for msg.Type, so forged server
+// fields (expiresAt, seq, timestamp, …) never reach the partner.
+func sanitize(msg api.ChatMessage) api.ChatMessage {
+	out := api.ChatMessage{Type: msg.Type, ConversationId: msg.ConversationId}
+	switch msg.Type {
+	case api.Chat:
+		out.Message, out.ClientMsgId = msg.Message, msg.ClientMsgId
+	case api.Read:
+		out.Id = msg.Id
+	}
+	return out
+}
+
+// errorEvent tells one client why its frame was rejected.
+func errorEvent(conversationID string, code api.ChatMessageCode, detail string) *api.ChatMessage {
+	msg := &api.ChatMessage{Type: api.ErrorEvent, ConversationId: conversationID, Code: &code}
+	if detail != "" {
+		msg.Message = &detail
+	}
+	return msg
+}
+
+// has reports whether u takes part in c. The caller holds Server.mu.
+func (c *conversation) has(u *user) bool {
+	for _, p := range c.Participants {
+		if p == u {
+			return true
+		}
+	}
+	return false
+}
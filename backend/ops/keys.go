@@ -0,0 +1,236 @@
+// backend/ops/keys.go
+// JWT signing keys. Several keys can be live at once so they can be rotated:
+// new tokens are signed with the active key, and any configured key is still
+// accepted for verification (selected by the token's `kid` header).
+
+package ops
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"os"
+	"sort"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"backend/api"
+	"backend/config"
+)
+
+const (
+	defaultIssuer   = "knock-knock"
+	defaultAudience = "knock-knock-api"
+	minHMACSecret   = 32 // bytes
+)
+
+type signingKey struct {
+	id     string
+	method jwt.SigningMethod
+	sign   interface{} // []byte | *rsa.PrivateKey | ed25519.PrivateKey; nil → verify only
+	verify interface{} // []byte | *rsa.PublicKey | ed25519.PublicKey
+}
+
+// KeySet holds every accepted key plus the claims tokens must carry.
+type KeySet struct {
+	issuer   string
+	audience string
+	active   *signingKey
+	byID     map[string]*signingKey
+}
+
+// LoadKeySet validates cfg and reads any referenced PEM files.
+func LoadKeySet(cfg config.JWTConfig) (*KeySet, error) {
+	ks := &KeySet{
+		issuer:   cfg.Issuer,
+		audience: cfg.Audience,
+		byID:     map[string]*signingKey{},
+	}
+	if ks.issuer == "" {
+		ks.issuer = defaultIssuer
+	}
+	if ks.audience == "" {
+		ks.audience = defaultAudience
+	}
+	for _, kc := range cfg.Keys {
+		if kc.ID == "" {
+			return nil, errors.New("jwt key without kid")
+		}
+		if _, dup := ks.byID[kc.ID]; dup {
+			return nil, fmt.Errorf("jwt key %q: duplicate kid", kc.ID)
+		}
+		k, err := loadKey(kc)
+		if err != nil {
+			return nil, fmt.Errorf("jwt key %q: %w", kc.ID, err)
+		}
+		ks.byID[kc.ID] = k
+	}
+	ks.active = ks.byID[cfg.ActiveKid]
+	if ks.active == nil {
+		return nil, fmt.Errorf("active jwt key %q is not configured", cfg.ActiveKid)
+	}
+	if ks.active.sign == nil {
+		return nil, fmt.Errorf("active jwt key %q has no private key", cfg.ActiveKid)
+	}
+	return ks, nil
+}
+
+// DevKeySet returns a throw‑away HS256 key for local runs. Tokens it signs do
+// not survive a restart.
+func DevKeySet(cfg config.JWTConfig) *KeySet {
+	secret := make([]byte, minHMACSecret)
+	_, _ = rand.Read(secret)
+	k := &signingKey{id: "dev", method: jwt.SigningMethodHS256, sign: secret, verify: secret}
+	ks := &KeySet{
+		issuer:   cfg.Issuer,
+		audience: cfg.Audience,
+		active:   k,
+		byID:     map[string]*signingKey{k.id: k},
+	}
+	if ks.issuer == "" {
+		ks.issuer = defaultIssuer
+	}
+	if ks.audience == "" {
+		ks.audience = defaultAudience
+	}
+	return ks
+}
+
+func loadKey(kc config.KeyConfig) (*signingKey, error) {
+	k := &signingKey{id: kc.ID}
+	switch kc.Algorithm {
+	case "HS256":
+		if len(kc.Secret) < minHMACSecret {
+			return nil, fmt.Errorf("HS256 secret must be at least %d bytes", minHMACSecret)
+		}
+		k.method = jwt.SigningMethodHS256
+		k.sign, k.verify = []byte(kc.Secret), []byte(kc.Secret)
+	case "RS256":
+		k.method = jwt.SigningMethodRS256
+		if kc.PrivateKeyFile != "" {
+			pem, err := os.ReadFile(kc.PrivateKeyFile)
+			if err != nil {
+				return nil, err
+			}
+			priv, err := jwt.ParseRSAPrivateKeyFromPEM(pem)
+			if err != nil {
+				return nil, err
+			}
+			if priv.N.BitLen() < 2048 {
+				return nil, errors.New("RSA keys must be at least 2048 bits")
+			}
+			k.sign, k.verify = priv, &priv.PublicKey
+		} else if kc.PublicKeyFile != "" {
+			pem, err := os.ReadFile(kc.PublicKeyFile)
+			if err != nil {
+				return nil, err
+			}
+			if k.verify, err = jwt.ParseRSAPublicKeyFromPEM(pem); err != nil {
+				return nil, err
+			}
+		} else {
+			return nil, errors.New("RS256 needs privateKeyFile or publicKeyFile")
+		}
+	case "EdDSA":
+		k.method = jwt.SigningMethodEdDSA
+		if kc.PrivateKeyFile != "" {
+			pem, err := os.ReadFile(kc.PrivateKeyFile)
+			if err != nil {
+				return nil, err
+			}
+			priv, err := jwt.ParseEdPrivateKeyFromPEM(pem)
+			if err != nil {
+				return nil, err
+			}
+			edPriv, ok := priv.(ed25519.PrivateKey)
+			if !ok {
+				return nil, errors.New("EdDSA key is not Ed25519")
+			}
+			k.sign, k.verify = edPriv, edPriv.Public()
+		} else if kc.PublicKeyFile != "" {
+			pem, err := os.ReadFile(kc.PublicKeyFile)
+			if err != nil {
+				return nil, err
+			}
+			if k.verify, err = jwt.ParseEdPublicKeyFromPEM(pem); err != nil {
+				return nil, err
+			}
+		} else {
+			return nil, errors.New("EdDSA needs privateKeyFile or publicKeyFile")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported alg %q (want HS256, RS256 or EdDSA)", kc.Algorithm)
+	}
+	return k, nil
+}
+
+// sign signs claims with the active key and stamps iss/aud and the kid header.
+func (ks *KeySet) sign(claims jwt.MapClaims) (string, error) {
+	claims["iss"] = ks.issuer
+	claims["aud"] = ks.audience
+	tok := jwt.NewWithClaims(ks.active.method, claims)
+	tok.Header["kid"] = ks.active.id
+	return tok.SignedString(ks.active.sign)
+}
+
+// parse verifies tokenStr strictly: a JWT typ header, known kid, the alg that
+// kid was configured with, our issuer and audience, and a present exp.
+func (ks *KeySet) parse(tokenStr string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(tokenStr, claims, ks.keyFor,
+		jwt.WithValidMethods([]string{"HS256", "RS256", "EdDSA"}),
+		jwt.WithIssuer(ks.issuer),
+		jwt.WithAudience(ks.audience),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func (ks *KeySet) keyFor(t *jwt.Token) (interface{}, error) {
+	if typ, _ := t.Header["typ"].(string); typ != "JWT" {
+		return nil, fmt.Errorf("token typ %q is not JWT", typ)
+	}
+	kid, _ := t.Header["kid"].(string)
+	k := ks.byID[kid]
+	if k == nil {
+		return nil, fmt.Errorf("unknown kid %q", kid)
+	}
+	// never let the token pick the algorithm for a key (e.g. HS256 with an RSA public key)
+	if t.Method.Alg() != k.method.Alg() {
+		return nil, fmt.Errorf("kid %q expects %s, token uses %s", kid, k.method.Alg(), t.Method.Alg())
+	}
+	return k.verify, nil
+}
+
+// jwks lists the public halves of every asymmetric key; HMAC secrets are
+// never published.
+func (ks *KeySet) jwks() api.JWKS {
+	set := api.JWKS{Keys: []api.JWK{}}
+	for _, k := range ks.byID {
+		jwk := api.JWK{Kid: k.id, Alg: k.method.Alg(), Use: "sig"}
+		switch pub := k.verify.(type) {
+		case *rsa.PublicKey:
+			n := base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			e := base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+			jwk.Kty, jwk.N, jwk.E = "RSA", &n, &e
+		case ed25519.PublicKey:
+			crv, x := "Ed25519", base64.RawURLEncoding.EncodeToString(pub)
+			jwk.Kty, jwk.Crv, jwk.X = "OKP", &crv, &x
+		default:
+			continue
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	sort.Slice(set.Keys, func(i, j int) bool { return set.Keys[i].Kid < set.Keys[j].Kid })
+	slog.Debug("Built JWKS", "keys", len(set.Keys))
+	return set
+}
@@ -0,0 +1,103 @@
+// backend/ops/lifecycle.go
+// Graceful shutdown: stop taking new sessions, end every conversation, tell
+// every connected client we are restarting, then wait for sockets and
+// in‑flight timer callbacks to finish.
+
+package ops
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"backend/api"
+)
+
+// drainGrace is how long Shutdown still waits for readers and timer
+// callbacks to unwind after its deadline has force‑closed every socket.
+const drainGrace = 2 * time.Second
+
+// track registers one unit of in‑flight work with the shutdown WaitGroup.
+// It returns false once draining has begun; the caller must then not start.
+func (s *Server) track() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.draining {
+		return false
+	}
+	s.wg.Add(1)
+	return true
+}
+
+// rejectDraining answers 503 with a Retry‑After hint.
+func rejectDraining(w http.ResponseWriter) {
+	w.Header().Set("Retry-After", strconv.Itoa(5))
+	writeError(w, http.StatusServiceUnavailable, "server_restarting", "")
+}
+
+// Shutdown drains the server. It returns ctx.Err() if clients did not close
+// in time, after force‑closing whatever was left.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.mu.Lock()
+	if s.draining {
+		s.mu.Unlock()
+		return nil
+	}
+	s.draining = true
+	convs := s.store.Conversations()
+	for _, c := range convs {
+		s.endConversation(c, api.ReasonServerShutdown)
+	}
+	conns := make([]*client, 0, len(s.connected))
+	for _, u := range s.connected {
+		conns = append(conns, u.conn)
+	}
+	s.mu.Unlock()
+	slog.Info("Draining", "conversations", len(convs), "connections", len(conns))
+
+	for _, c := range conns {
+		c.restarting()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		slog.Info("Drain complete")
+		return nil
+	case <-ctx.Done():
+		slog.Warn("Drain deadline hit; closing remaining connections", "error", ctx.Err())
+		s.mu.Lock()
+		for _, u := range s.connected {
+			conns = append(conns, u.conn)
+		}
+		s.mu.Unlock()
+		for _, c := range conns {
+			_ = c.conn.Close()
+		}
+		select {
+		case <-done:
+		case <-time.After(drainGrace):
+			slog.Error("Work still in flight after closing every connection; giving up", "grace", drainGrace)
+		}
+		return ctx.Err()
+	}
+}
+
+// restarting tells c the server is going away and closes it with 1012. Both
+// are queued behind anything already pending, so the client sees the last
+// chat lines before the notice and the close.
+func (c *client) restarting() {
+	now := time.Now().UTC()
+	if !c.send(api.ChatMessage{Type: api.ServerRestarting, Timestamp: &now}) {
+		slog.Warn("Failed to send server_restarting", "userID", c.userID)
+	}
+	c.close(websocket.CloseServiceRestart, "server restarting")
+}
@@ -0,0 +1,240 @@
+// backend/ops/match.go
+// Choosing who shares the next round. Rounds hold chat.groupSize users.
+// A newly queued user holds out for people who share an interest tag and a
+// language; after chat.matchWait they take anyone. People paired within
+// chat.rematchCooldown are kept apart for as long as any other group can
+// be formed, and never while one of them is still holding out. Among
+// acceptable candidates the queue front goes first, and whoever has the
+// most tags in common with the front user is preferred. Users whose socket
+// is not open yet keep their place but are passed over.
+
+package ops
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"backend/api"
+)
+
+const (
+	maxInterests      = 10
+	maxInterestLength = 32
+	maxLanguageLength = 16
+)
+
+// matchPrefs normalizes the interests and language a user joins the queue
+// with, from /session/anonymous or the /ws/chat query: tags are trimmed,
+// lowercased and deduplicated, empty ones dropped.
+func matchPrefs(req api.AnonymousSessionRequest) (interests []string, language string, err error) {
+	if req.Interests != nil {
+		if len(*req.Interests) > maxInterests {
+			return nil, "", fmt.Errorf("at most %d interests", maxInterests)
+		}
+		seen := map[string]bool{}
+		for _, t := range *req.Interests {
+			t = strings.ToLower(strings.TrimSpace(t))
+			if len([]rune(t)) > maxInterestLength {
+				return nil, "", fmt.Errorf("interest %q is longer than %d characters", t, maxInterestLength)
+			}
+			if t != "" && !seen[t] {
+				seen[t] = true
+				interests = append(interests, t)
+			}
+		}
+	}
+	if req.Language != nil {
+		language = strings.ToLower(strings.TrimSpace(*req.Language))
+		if len(language) > maxLanguageLength {
+			return nil, "", errors.New("language tag is too long")
+		}
+	}
+	return interests, language, nil
+}
+
+// enqueue puts users at the back of the queue and starts their wait for a
+// like‑minded match. The caller holds s.mu.
+func (s *Server) enqueue(us ...*user) {
+	now := time.Now()
+	for _, u := range us {
+		u.queuedAt = now
+	}
+	s.store.Enqueue(us...)
+}
+
+// picky reports whether u still holds out for shared interests, language
+// and new faces.
+func (s *Server) picky(u *user, now time.Time) bool {
+	return now.Sub(u.queuedAt) < s.cfg.Chat.MatchWait
+}
+
+// accepts reports whether u is willing to share a round with v right now.
+// A recent partner is only acceptable with rematch set, and only once u has
+// stopped being picky.
+func (s *Server) accepts(u, v *user, now time.Time, rematch bool) bool {
+	if u.blocks[v.ID] {
+		return false // ever
+	}
+	if s.metRecently(u, v, now) && (!rematch || s.picky(u, now)) {
+		return false
+	}
+	if !s.picky(u, now) {
+		return true
+	}
+	if u.language != "" && v.language != "" && u.language != v.language {
+		return false
+	}
+	return len(u.interests) == 0 || len(sharedInterests([]*user{u, v})) > 0
+}
+
+// noteMet records that everyone in group has just been paired and forgets
+// meetings older than chat.rematchCooldown. The caller holds s.mu.
+func (s *Server) noteMet(group []*user, now time.Time) {
+	if s.cfg.Chat.RematchCooldown <= 0 {
+		return
+	}
+	for _, u := range group {
+		for id, at := range u.metAt {
+			if now.Sub(at) >= s.cfg.Chat.RematchCooldown {
+				delete(u.metAt, id)
+			}
+		}
+		if u.metAt == nil {
+			u.metAt = map[string]time.Time{}
+		}
+		for _, v := range group {
+			if v != u {
+				u.metAt[v.ID] = now
+			}
+		}
+	}
+}
+
+// metRecently reports whether u was paired with v within chat.rematchCooldown.
+func (s *Server) metRecently(u, v *user, now time.Time) bool {
+	at, ok := u.metAt[v.ID]
+	return ok && now.Sub(at) < s.cfg.Chat.RematchCooldown
+}
+
+// fits reports whether v and everyone in group accept each other.
+func (s *Server) fits(group []*user, v *user, now time.Time, rematch bool) bool {
+	for _, g := range group {
+		if !s.accepts(g, v, now, rematch) || !s.accepts(v, g, now, rematch) {
+			return false
+		}
+	}
+	return true
+}
+
+// nextGroup returns the next chat.groupSize distinct connected users who
+// all accept each other, or nil if no such group is waiting. Groups without
+// recent partners win; only if there is none may people meet again. The
+// caller holds s.mu.
+func (s *Server) nextGroup(now time.Time) []*user {
+	var waiting []*user
+	seen := map[*user]bool{}
+	for _, u := range s.store.Queue() {
+		if u.conn != nil && !seen[u] {
+			seen[u] = true
+			waiting = append(waiting, u)
+		}
+	}
+	if g := s.findGroup(waiting, now, false); g != nil {
+		return g
+	}
+	return s.findGroup(waiting, now, true)
+}
+
+// findGroup builds a group from waiting, trying each user as its front in
+// queue order. rematch lets recent partners back together.
+func (s *Server) findGroup(waiting []*user, now time.Time, rematch bool) []*user {
+	for i, front := range waiting {
+		rest := make([]*user, 0, len(waiting)-1)
+		rest = append(rest, waiting[:i]...)
+		rest = append(rest, waiting[i+1:]...)
+		sort.SliceStable(rest, func(a, b int) bool {
+			return len(sharedInterests([]*user{front, rest[a]})) > len(sharedInterests([]*user{front, rest[b]}))
+		})
+		group := []*user{front}
+		for _, v := range rest {
+			if s.fits(group, v, now, rematch) {
+				group = append(group, v)
+				if len(group) == s.cfg.Chat.GroupSize {
+					return group
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// sharedInterests lists the interests every one of us gave, in the first
+// user's order.
+func sharedInterests(us []*user) []string {
+	shared := []string{}
+	for _, t := range us[0].interests {
+		all := true
+		for _, u := range us[1:] {
+			has := false
+			for _, o := range u.interests {
+				if o == t {
+					has = true
+					break
+				}
+			}
+			if !has {
+				all = false
+				break
+			}
+		}
+		if all {
+			shared = append(shared, t)
+		}
+	}
+	return shared
+}
+
+// scheduleWiden reruns tryPair when the next picky waiter gives up being
+// picky, so a lone tag fan is not left waiting for someone else to join.
+// The caller holds s.mu.
+func (s *Server) scheduleWiden(now time.Time) {
+	var next time.Time
+	waiting := 0
+	for _, u := range s.store.Queue() {
+		if u.conn == nil {
+			continue
+		}
+		waiting++
+		if at := u.queuedAt.Add(s.cfg.Chat.MatchWait); at.After(now) && (next.IsZero() || at.Before(next)) {
+			next = at
+		}
+	}
+	if waiting < s.cfg.Chat.GroupSize || next.IsZero() {
+		return
+	}
+	if s.widen != nil {
+		s.widen.Stop()
+	}
+	s.widen = time.AfterFunc(next.Sub(now), func() {
+		if !s.track() {
+			return // shutting down
+		}
+		defer s.wg.Done()
+		slog.Debug("Match wait over; widening")
+		s.tryPair()
+	})
+}
+
+// members lists the user IDs in c, for paired and resumed events. The
+// caller holds s.mu.
+func (c *conversation) members() []string {
+	ids := make([]string, len(c.Participants))
+	for i, p := range c.Participants {
+		ids[i] = p.ID
+	}
+	return ids
+}
@@ -0,0 +1,211 @@
+// backend/ops/moderation.go
+// Reporting and blocking partners. Both work on internal user IDs, so they
+// are open to anonymous users too, and both reach back to the caller's
+// latest round: an abusive partner has usually ended it by the time the
+// user gets to the button. A block is for good — the matcher never puts the
+// two in a round again — and a report is filed for moderators.
+
+package ops
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"backend/api"
+)
+
+const maxReportNote = 1000 // characters
+
+// pastRound is what a user remembers of their latest round.
+type pastRound struct {
+	ID      string
+	members []string // user IDs at the start, the user included
+}
+
+// only returns the one member of r besides self, if there is exactly one.
+func (r pastRound) only(self string) (string, bool) {
+	var other string
+	for _, id := range r.members {
+		if id == self {
+			continue
+		}
+		if other != "" {
+			return "", false
+		}
+		other = id
+	}
+	return other, other != ""
+}
+
+// had reports whether id was a member of r.
+func (r pastRound) had(id string) bool {
+	for _, m := range r.members {
+		if m == id {
+			return true
+		}
+	}
+	return false
+}
+
+func validReportReason(r api.ReportRequestReason) bool {
+	switch r {
+	case api.Harassment, api.Spam, api.Inappropriate, api.Underage, api.Other:
+		return true
+	}
+	return false
+}
+
+// POST /conversations/{id}/report
+func (s *Server) PostConversationsIdReport(w http.ResponseWriter, r *http.Request, id string) {
+	slog.Info("Handling POST /conversations/{id}/report", "conversationID", id)
+	bearer := r.Header.Get("Authorization")
+	if !strings.HasPrefix(bearer, "Bearer ") {
+		slog.Warn("Missing token in request")
+		writeError(w, http.StatusUnauthorized, "missing_token", "")
+		return
+	}
+	u, err := s.userFromJWT(strings.TrimPrefix(bearer, "Bearer "))
+	if err != nil || u == nil {
+		slog.Warn("Invalid token", "error", err)
+		writeError(w, http.StatusUnauthorized, "invalid_token", "")
+		return
+	}
+	var req api.ReportRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		slog.Error("Failed to decode request", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !validReportReason(req.Reason) {
+		writeError(w, http.StatusBadRequest, "invalid_reason", "")
+		return
+	}
+	var note string
+	if req.Note != nil {
+		note = strings.TrimSpace(*req.Note)
+		if len([]rune(note)) > maxReportNote {
+			writeError(w, http.StatusBadRequest, "note_too_long", "")
+			return
+		}
+	}
+
+	s.mu.RLock()
+	round := u.lastRound
+	s.mu.RUnlock()
+	if round.ID == "" || round.ID != id {
+		writeError(w, http.StatusNotFound, "conversation_not_found", "")
+		return
+	}
+	var target string
+	if req.UserId != nil {
+		target = *req.UserId
+		if target == u.ID || !round.had(target) {
+			writeError(w, http.StatusNotFound, "user_not_found", "not a member of that conversation")
+			return
+		}
+	} else if target, _ = round.only(u.ID); target == "" {
+		writeError(w, http.StatusBadRequest, "user_required", "the conversation had more than one other member")
+		return
+	}
+
+	rep := report{
+		ID:             genID(),
+		ConversationID: id,
+		ReporterID:     u.ID,
+		ReportedID:     target,
+		Reason:         string(req.Reason),
+		Note:           note,
+		At:             time.Now().UTC(),
+	}
+	if err := s.store.SaveReport(rep); err != nil {
+		slog.Error("Failed to save report", "error", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+	slog.Warn("User reported", "reportID", rep.ID, "conversationID", id, "reporterID", u.ID, "reportedID", target, "reason", rep.Reason)
+}
+
+// POST /block
+func (s *Server) PostBlock(w http.ResponseWriter, r *http.Request) {
+	slog.Info("Handling POST /block")
+	bearer := r.Header.Get("Authorization")
+	if !strings.HasPrefix(bearer, "Bearer ") {
+		slog.Warn("Missing token in request")
+		writeError(w, http.StatusUnauthorized, "missing_token", "")
+		return
+	}
+	u, err := s.userFromJWT(strings.TrimPrefix(bearer, "Bearer "))
+	if err != nil || u == nil {
+		slog.Warn("Invalid token", "error", err)
+		writeError(w, http.StatusUnauthorized, "invalid_token", "")
+		return
+	}
+	var req api.BlockRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		slog.Error("Failed to decode request", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var target string
+	if req.UserId != nil {
+		target = *req.UserId
+	} else {
+		s.mu.RLock()
+		target, _ = u.lastRound.only(u.ID)
+		s.mu.RUnlock()
+		if target == "" {
+			writeError(w, http.StatusBadRequest, "user_required", "no single partner to block")
+			return
+		}
+	}
+	if target == u.ID {
+		writeError(w, http.StatusBadRequest, "self_block", "")
+		return
+	}
+	other, err := s.store.UserByID(target)
+	if err != nil {
+		slog.Error("Failed to load user", "userID", target, "error", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	if other == nil {
+		writeError(w, http.StatusNotFound, "user_not_found", "")
+		return
+	}
+	if err := s.store.Block(u.ID, other.ID, time.Now()); err != nil {
+		slog.Error("Failed to save block", "error", err)
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+
+	s.mu.Lock()
+	if u.blocks == nil {
+		u.blocks = map[string]bool{}
+	}
+	u.blocks[other.ID] = true
+	conv := s.conversationOf(u)
+	left := conv != nil && conv.has(other)
+	if left {
+		// leave like a skip; the partner need not learn about the block
+		if u.away != nil {
+			u.away.Stop()
+			u.away = nil
+		}
+		s.leaveConversations(u, api.ReasonPartnerSkipped)
+		s.store.Dequeue(u)
+		s.enqueue(u)
+	}
+	s.mu.Unlock()
+	if left {
+		s.tryPair()
+	}
+	w.WriteHeader(http.StatusNoContent)
+	slog.Info("User blocked", "userID", u.ID, "blockedID", other.ID, "leftConversation", left)
+}
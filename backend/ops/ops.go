@@ -2,7 +2,7 @@
 // Ephemeral Chat reference server that conforms to the OpenAPI spec in api/.
 // Focus: minimal but functional flows for /session/anonymous, /account/register,
 // /login, /me, /session/skip, /ping, and the WebSocket chat endpoint.
-// Users start anonymous, are paired 1‑on‑1 for 3‑minute rounds, may skip, and
+// Users start anonymous, are grouped (1‑on‑1 by default) for 3‑minute rounds, may skip, and
 // may register a unique username to persist.
 
 package ops
@@ -11,8 +11,11 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"net"
 	"net/http"
 	"strings"
 	"sync"
@@ -22,43 +25,44 @@ import (
 	"github.com/gorilla/websocket"
 
 	"backend/api"
-)
-
-// ─── CONFIG ────────────────────────────────────────────────────────────────
-var (
-    jwtKey           = []byte("super‑secret‑dev‑key")
-    anonSessionTTL   = 100 * 365 * 24 * time.Hour
-    registeredTTL    = 24 * time.Hour
-    roundDuration    = 3 * time.Minute
-    skipCooldown     = 10 * time.Second // throttle rapid skips → 429
+	"backend/config"
 )
 
 // ─── MODELS ────────────────────────────────────────────────────────────────
 
+// ID is immutable. Every other field is shared between handlers, the
+// socket reader and round timers, and is only touched with Server.mu held.
 type user struct {
     ID           string
     Username     string // empty until registered
+    passwordHash []byte // bcrypt; empty until registered
     lastSkipTime time.Time
-    conn         *websocket.Conn
+    conn         *client              // nil while not connected
+    away         *time.Timer          // resume grace window, while conn is nil mid‑round
+    awaySeq      int64                // conversation seq when the socket dropped
+    lastQueued   *api.QueueStatus     // last queued event sent; nil when not waiting
+    queuedAt     time.Time            // when the user last joined the queue
+    interests    []string             // normalized interest tags, for matching
+    language     string               // preferred chat language, lowercase; "" for any
+    metAt        map[string]time.Time // partner ID → when last paired, within chat.rematchCooldown
+    blocks       map[string]bool      // IDs of users this one blocked
+    lastRound    pastRound            // latest round, for report and block
 }
 
+// Participants and timer are guarded by Server.mu like the user fields.
 type conversation struct {
     ID           string
-    Participants []*user // always size ≥2 (1‑on‑1 for now)
+    Participants []*user // chat.groupSize at first; the round ends below 2
     timer        *time.Timer
     expiresAt    time.Time
+    seq          int64                      // last chat seq handed out
+    history      []logged                   // last chat.replayBuffer chat messages, for resume
+    acks         map[string]api.ChatMessage // by sender + clientMsgId, for dedupe
+    extendVotes  map[*user]bool             // who wants more time this round
+    extensions   int                        // extensions granted so far
+    connectVotes map[*user]bool             // who wants to keep in touch
 }
 
-// ─── IN‑MEMORY STORE ───────────────────────────────────────────────────────
-
-var (
-    mu            sync.RWMutex
-    usersByID     = map[string]*user{}
-    usersByName   = map[string]*user{}
-    waitingQueue  []*user
-    conversations = map[string]*conversation{}
-)
-
 // ─── HELPERS ───────────────────────────────────────────────────────────────
 
 func genID() string {
@@ -68,37 +72,116 @@ func genID() string {
     return base64.RawURLEncoding.EncodeToString(b)
 }
 
-func issueJWT(u *user, ttl time.Duration) (string, error) {
-    slog.Info("Issuing JWT", "userID", u.ID, "username", u.Username, "ttl", ttl)
+func (s *Server) authResponse(sess session) api.AuthResponse {
+    return api.AuthResponse{
+        Token:            sess.AccessToken,
+        RefreshToken:     sess.RefreshToken,
+        ExpiresInSeconds: int32(s.cfg.Auth.AccessTokenTTL.Seconds()),
+    }
+}
+
+// writeError sends the api.Error envelope used by every JSON error response.
+func writeError(w http.ResponseWriter, status int, code, details string) {
+    body := api.Error{Error: code}
+    if details != "" {
+        body.Details = &details
+    }
+    w.Header().Set("Content-Type", "application/json")
+    w.WriteHeader(status)
+    _ = json.NewEncoder(w).Encode(body)
+}
+
+// usernameOf reads u.Username for callers that do not hold s.mu.
+func (s *Server) usernameOf(u *user) string {
+    s.mu.RLock()
+    defer s.mu.RUnlock()
+    return u.Username
+}
+
+// clientsOf snapshots the open connections of c's participants so events
+// can be sent after s.mu is released. The caller holds s.mu.
+func clientsOf(c *conversation) []*client {
+    out := make([]*client, 0, len(c.Participants))
+    for _, p := range c.Participants {
+        if p.conn != nil {
+            out = append(out, p.conn)
+        }
+    }
+    return out
+}
+
+// issueJWT signs an access token; family ties it to its refresh token family.
+func (s *Server) issueJWT(u *user, family string, ttl time.Duration) (string, error) {
+    username := s.usernameOf(u)
+    slog.Info("Issuing JWT", "userID", u.ID, "username", username, "ttl", ttl)
+    now := time.Now()
     claims := jwt.MapClaims{
         "sub":      u.ID,
-        "username": u.Username,
-        "exp":      time.Now().Add(ttl).Unix(),
+        "username": username,
+        "jti":      genID(),
+        "sid":      family,
+        "iat":      now.Unix(),
+        "exp":      now.Add(ttl).Unix(),
     }
-    return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(jwtKey)
+    return s.keys.sign(claims)
 }
 
-func userFromJWT(tokenStr string) (*user, error) {
-    slog.Info("Parsing JWT", "token", tokenStr)
-    tok, err := jwt.Parse(tokenStr, func(t *jwt.Token) (interface{}, error) { return jwtKey, nil })
-    if err != nil || !tok.Valid {
+// parseJWT verifies tokenStr (see KeySet.parse) and rejects it if its jti or
+// family is revoked.
+func (s *Server) parseJWT(tokenStr string) (jwt.MapClaims, error) {
+    claims, err := s.keys.parse(tokenStr)
+    if err != nil {
         slog.Error("Invalid JWT", "error", err)
         return nil, err
     }
-    claims := tok.Claims.(jwt.MapClaims)
-    id := claims["sub"].(string)
-    u := usersByID[id]
+    jti, _ := claims["jti"].(string)
+    sid, _ := claims["sid"].(string)
+    if jti == "" {
+        return nil, errTokenNoID
+    }
+    revoked, err := s.store.IsRevoked(jti, sid)
+    if err != nil {
+        slog.Error("Failed to check revocation", "jti", jti, "error", err)
+        return nil, err
+    }
+    if revoked {
+        slog.Warn("Revoked JWT presented", "jti", jti, "sid", sid)
+        return nil, errTokenRevoked
+    }
+    return claims, nil
+}
+
+func (s *Server) userFromJWT(tokenStr string) (*user, error) {
+    slog.Info("Parsing JWT", "token", tokenStr)
+    claims, err := s.parseJWT(tokenStr)
+    if err != nil {
+        return nil, err
+    }
+    id, _ := claims["sub"].(string)
+    if id == "" {
+        slog.Warn("JWT without subject")
+        return nil, errTokenNoSubject
+    }
+    u, err := s.store.UserByID(id)
+    if err != nil {
+        slog.Error("Failed to load user", "userID", id, "error", err)
+        return nil, err
+    }
     slog.Info("User retrieved from JWT", "userID", id)
     return u, nil
 }
 
 // ─── PAIRING LOGIC ─────────────────────────────────────────────────────────
-func tryPair() {
+func (s *Server) tryPair() {
     slog.Info("Attempting to pair users")
-    mu.Lock()
-    defer mu.Unlock()
+    s.mu.Lock()
+    defer s.mu.Unlock()
+    if s.draining {
+        return
+    }
 
     // 1) Log current queue
+    waitingQueue := s.store.Queue()
     slog.Info("Current waiting queue", "queueLength", len(waitingQueue), "userIDs", func() []string {
         ids := make([]string, len(waitingQueue))
         for i, u := range waitingQueue {
@@ -107,150 +190,277 @@ func tryPair() {
         return ids
     }())
 
-    // 2) Keep pairing as long as we can find two connected users
+    // 2) Keep forming rounds as long as enough users are connected
+    start := time.Now()
     for {
-        n := len(waitingQueue)
-        var i, j int
-        found := false
-
-        // find two distinct indices whose conn != nil
-        for x := 0; x < n-1 && !found; x++ {
-            u1 := waitingQueue[x]
-            if u1.conn == nil {
-                continue
-            }
-            for y := x + 1; y < n; y++ {
-                u2 := waitingQueue[y]
-                if u2.conn == nil {
-                    continue
-                }
-                if u1.ID != u2.ID {
-                    i, j = x, y
-                    found = true
-                    break
-                }
-            }
-        }
-
-        if !found {
+        group := s.nextGroup(start)
+        if group == nil {
             slog.Info("Not enough connected users to pair; stopping")
             break
         }
 
-        // 3) Extract the two users and remove them (higher index first)
-        a, b := waitingQueue[i], waitingQueue[j]
-        slog.Info("Pairing users", "userA", a.ID, "userB", b.ID)
-        if j > i {
-            waitingQueue = append(waitingQueue[:j], waitingQueue[j+1:]...)
-            waitingQueue = append(waitingQueue[:i], waitingQueue[i+1:]...)
-        } else {
-            waitingQueue = append(waitingQueue[:i], waitingQueue[i+1:]...)
-            waitingQueue = append(waitingQueue[:j], waitingQueue[j+1:]...)
+        // 3) Remove the group from the queue
+        for _, p := range group {
+            s.store.Dequeue(p)
+            p.lastQueued = nil
         }
+        s.notePair(start)
+        s.noteMet(group, start)
 
         // 4) Create and record the conversation
         conv := &conversation{
             ID:           genID(),
-            Participants: []*user{a, b},
-            expiresAt:    time.Now().Add(roundDuration),
+            Participants: group,
+            expiresAt:    time.Now().Add(s.cfg.Chat.RoundDuration),
+        }
+        round := pastRound{ID: conv.ID, members: conv.members()}
+        for _, p := range group {
+            p.lastRound = round
+        }
+        slog.Info("Pairing users", "userIDs", round.members)
+        if err := s.store.SaveConversation(conv); err != nil {
+            slog.Error("Failed to save conversation", "conversationID", conv.ID, "error", err)
         }
-        conversations[conv.ID] = conv
         slog.Info("Created conversation", "conversationID", conv.ID)
 
-        // 5) Notify both participants
+        // 5) Notify every participant
         now := time.Now().UTC()
+        expiresAt := conv.expiresAt // a copy: extensions move conv.expiresAt
+        members := conv.members()
+        shared := sharedInterests(group)
         for _, p := range conv.Participants {
-            text := fmt.Sprintf("paired with %s", func() string {
-                if conv.Participants[0] == p {
-                    return conv.Participants[1].ID
+            others := make([]string, 0, len(members)-1)
+            for _, id := range members {
+                if id != p.ID {
+                    others = append(others, id)
                 }
-                return conv.Participants[0].ID
-            }())
+            }
+            text := fmt.Sprintf("paired with %s", strings.Join(others, ", "))
             msg := api.ChatMessage{
-                Type:           api.Paired,
-                ConversationId: conv.ID,
-                Message:        &text,
-                Timestamp:      &now,
-                ExpiresAt:      &conv.expiresAt,
+                Type:            api.Paired,
+                ConversationId:  conv.ID,
+                Message:         &text,
+                Timestamp:       &now,
+                ExpiresAt:       &expiresAt,
+                Members:         &members,
+                SharedInterests: &shared,
             }
             // p.conn is guaranteed non-nil here
-            if err := p.conn.WriteJSON(msg); err != nil {
-                slog.Error("Failed to send pairing notification", "userID", p.ID, "error", err)
+            if !p.conn.send(msg) {
+                slog.Error("Failed to send pairing notification", "userID", p.ID)
             } else {
                 slog.Info("Sent pairing notification", "userID", p.ID)
             }
         }
 
         // 6) Schedule automatic timeout
-        conv.timer = time.AfterFunc(roundDuration, func() {
+        conv.timer = time.AfterFunc(s.cfg.Chat.RoundDuration, func() {
+            if !s.track() {
+                return // shutting down; Shutdown ends the conversation
+            }
+            defer s.wg.Done()
+            s.mu.Lock()
+            if s.store.Conversation(conv.ID) != conv {
+                s.mu.Unlock()
+                return // a skip ended it while this callback was starting
+            }
             slog.Info("Conversation timed out", "conversationID", conv.ID)
-            mu.Lock()
-            delete(conversations, conv.ID)
-            waitingQueue = append(waitingQueue, a, b)
-            mu.Unlock()
 
-            // send time_up to anyone still connected
+            // send time_up to anyone still connected; conversation_ended follows
             now := time.Now().UTC()
             notify := api.ChatMessage{
                 Type:           api.TimeUp,
                 ConversationId: conv.ID,
                 Timestamp:      &now,
             }
-            for _, p := range []*user{a, b} {
-                if p.conn != nil {
-                    if err := p.conn.WriteJSON(notify); err != nil {
-                        slog.Warn("Failed to send time_up", "userID", p.ID, "error", err)
-                    }
+            for _, c := range clientsOf(conv) {
+                if !c.send(notify) {
+                    slog.Warn("Failed to send time_up", "userID", c.userID)
                 }
             }
+            s.endConversation(conv, api.ReasonTimeUp)
+            s.enqueue(conv.Participants...)
+            s.mu.Unlock()
 
             // try to form new pairs
-            tryPair()
+            s.tryPair()
         })
 
         // loop around to see if we can pair more users...
     }
+
+    // 7) Tell whoever is still waiting where they stand, and come back when
+    //    someone's match wait runs out
+    s.notifyQueue()
+    s.scheduleWiden(start)
 }
 
 
 
 
+// endConversation tears c down and tells everyone still in it why. The
+// caller holds s.mu and decides where the participants go next.
+func (s *Server) endConversation(c *conversation, reason api.ChatMessageReason) {
+    if c.timer != nil {
+        c.timer.Stop()
+    }
+    if err := s.store.EndConversation(c); err != nil {
+        slog.Error("Failed to end conversation", "conversationID", c.ID, "error", err)
+    }
+    now := time.Now().UTC()
+    ended := api.ChatMessage{
+        Type:           api.ConversationEnded,
+        ConversationId: c.ID,
+        Reason:         &reason,
+        Timestamp:      &now,
+    }
+    for _, cl := range clientsOf(c) {
+        cl.send(ended)
+    }
+    slog.Info("Conversation ended", "conversationID", c.ID, "reason", reason)
+}
+
+// leaveConversations removes u from every conversation it is in. A
+// conversation left with fewer than two participants ends for reason and
+// whoever remains goes back to the queue; a bigger one goes on without u.
+// The caller holds s.mu and calls tryPair after releasing it.
+func (s *Server) leaveConversations(u *user, reason api.ChatMessageReason) {
+    for _, c := range s.store.Conversations() {
+        rest := make([]*user, 0, len(c.Participants))
+        for _, p := range c.Participants {
+            if p != u {
+                rest = append(rest, p)
+            }
+        }
+        if len(rest) == len(c.Participants) {
+            continue
+        }
+        c.Participants = rest
+        if len(c.Participants) < 2 {
+            slog.Info("Last partner left", "conversationID", c.ID, "userID", u.ID)
+            s.endConversation(c, reason)
+            s.enqueue(c.Participants...)
+            continue
+        }
+        s.memberLeft(c, u, reason)
+    }
+}
+
+// memberLeft tells the rest of c that u left for reason. u's votes no longer
+// count, so a vote only u was holding up passes now. The caller holds s.mu.
+func (s *Server) memberLeft(c *conversation, u *user, reason api.ChatMessageReason) {
+    slog.Info("Member left", "conversationID", c.ID, "userID", u.ID, "remaining", len(c.Participants))
+    now := time.Now().UTC()
+    left := api.ChatMessage{
+        Type:           api.MemberLeft,
+        ConversationId: c.ID,
+        From:           &u.ID,
+        Reason:         &reason,
+        Timestamp:      &now,
+    }
+    for _, cl := range clientsOf(c) {
+        cl.send(left)
+    }
+    delete(c.extendVotes, u)
+    delete(c.connectVotes, u)
+    if len(c.extendVotes) > 0 && c.unanimous(c.extendVotes) {
+        s.extend(c)
+    }
+    if len(c.connectVotes) > 0 && c.unanimous(c.connectVotes) {
+        s.connect(c)
+    }
+}
+
 // ─── SERVER IMPLEMENTATION (api.ServerInterface) ──────────────────────────
 
 // Server implements every handler in api.ServerInterface.
-type Server struct{}
+type Server struct {
+    cfg   *config.Config
+    store Store
+    keys  *KeySet
+    mu    sync.RWMutex // guards pairing, conversation membership and user live state
+
+    connected map[string]*user // users with an open socket, by ID
+    pairings  []time.Time      // recent pair times, for wait estimates
+    widen     *time.Timer      // reruns tryPair once the next waiter takes anyone
+    filters   []messageFilter  // moderation chain for chat messages, in order
+    draining  bool             // set once by Shutdown; refuse new work
+    wg        sync.WaitGroup   // open sockets + running timer callbacks
+}
 
 // Compile‑time proof that *Server satisfies the interface.
 var _ api.ServerInterface = (*Server)(nil)
 
-// constructor – makes it easy for main/server package
-func New() *Server { return &Server{} }
+// constructor – makes it easy for main/server package; nil store → in‑memory.
+// cfg must already be validated (config.Load does that).
+func New(cfg *config.Config, store Store) (*Server, error) {
+    if store == nil {
+        store = NewMemoryStore()
+    }
+    var keys *KeySet
+    if len(cfg.Auth.JWT.Keys) == 0 {
+        slog.Warn("No JWT keys configured; using a random dev key (tokens die on restart)")
+        keys = DevKeySet(cfg.Auth.JWT)
+    } else {
+        var err error
+        if keys, err = LoadKeySet(cfg.Auth.JWT); err != nil {
+            return nil, err
+        }
+    }
+    filters, err := newFilters(cfg.Moderation)
+    if err != nil {
+        return nil, err
+    }
+    return &Server{cfg: cfg, store: store, keys: keys, connected: map[string]*user{}, filters: filters}, nil
+}
 
 // POST /session/anonymous
 func (s *Server) PostSessionAnonymous(w http.ResponseWriter, r *http.Request) {
     slog.Info("Handling POST /session/anonymous")
-    // var req api.AnonymousSessionRequest
-    // if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-    //     slog.Error("Failed to decode request", "error", err)
-    //     http.Error(w, err.Error(), http.StatusBadRequest)
-    //     return
-    // }
-
-    u := &user{ID: genID()}
-    mu.Lock()
-    usersByID[u.ID] = u
-    waitingQueue = append(waitingQueue, u)
-    mu.Unlock()
-
-    token, _ := issueJWT(u, anonSessionTTL)
-    tryPair()
+    var req api.AnonymousSessionRequest
+    if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+        slog.Error("Failed to decode request", "error", err)
+        http.Error(w, err.Error(), http.StatusBadRequest)
+        return
+    }
+    interests, language, err := matchPrefs(req)
+    if err != nil {
+        slog.Warn("Rejected match preferences", "error", err)
+        writeError(w, http.StatusBadRequest, "invalid_interests", err.Error())
+        return
+    }
+
+    u := &user{ID: genID(), interests: interests, language: language}
+    s.mu.Lock()
+    if s.draining {
+        s.mu.Unlock()
+        rejectDraining(w)
+        return
+    }
+    if err := s.store.SaveUser(u); err != nil {
+        s.mu.Unlock()
+        slog.Error("Failed to save user", "userID", u.ID, "error", err)
+        http.Error(w, "internal error", http.StatusInternalServerError)
+        return
+    }
+    s.enqueue(u)
+    s.mu.Unlock()
+
+    sess, err := s.startSession(u)
+    if err != nil {
+        slog.Error("Failed to start session", "userID", u.ID, "error", err)
+        http.Error(w, "internal error", http.StatusInternalServerError)
+        return
+    }
+    s.tryPair()
 
     scheme := "ws"
     if r.TLS != nil { scheme = "wss" }
     resp := api.AnonymousSessionResponse{
-        Token:         token,
-        WebsocketUrl:  fmt.Sprintf("%s://%s/api/ws/chat?token=%s", scheme, r.Host, token),
-        ExpiresInSeconds: int32(anonSessionTTL.Seconds()),
+        Token:         sess.AccessToken,
+        RefreshToken:  sess.RefreshToken,
+        WebsocketUrl:  fmt.Sprintf("%s://%s/api/ws/chat?token=%s", scheme, r.Host, sess.AccessToken),
+        ExpiresInSeconds: int32(s.cfg.Auth.AccessTokenTTL.Seconds()),
     }
     w.Header().Set("Content-Type", "application/json")
     w.WriteHeader(http.StatusCreated)
@@ -268,36 +478,70 @@ func (s *Server) PostAccountRegister(w http.ResponseWriter, r *http.Request) {
         return
     }
 
-    mu.Lock()
-    if _, exists := usersByName[req.Username]; exists {
-        mu.Unlock()
-        slog.Warn("Username already exists", "username", req.Username)
-        w.Header().Set("Content-Type", "application/json")
-        w.WriteHeader(http.StatusConflict)
-        _ = json.NewEncoder(w).Encode(api.Error{Error: "username_exists"})
+    req.Username = strings.TrimSpace(req.Username)
+    if req.Username == "" {
+        writeError(w, http.StatusBadRequest, "invalid_username", "username must not be empty")
+        return
+    }
+    if err := checkPasswordPolicy(req.Username, req.Password); err != nil {
+        slog.Warn("Password rejected by policy", "username", req.Username, "reason", err)
+        writeError(w, http.StatusBadRequest, "weak_password", err.Error())
+        return
+    }
+    hash, err := hashPassword(req.Password)
+    if err != nil {
+        slog.Error("Failed to hash password", "error", err)
+        http.Error(w, "internal error", http.StatusInternalServerError)
         return
     }
+
     bearer := r.Header.Get("Authorization")
     var u *user
     if strings.HasPrefix(bearer, "Bearer ") {
-        u, _ = userFromJWT(strings.TrimPrefix(bearer, "Bearer "))
+        u, _ = s.userFromJWT(strings.TrimPrefix(bearer, "Bearer "))
     }
+    s.mu.Lock()
     if u == nil {
         u = &user{ID: genID()}
-        usersByID[u.ID] = u
     }
-    u.Username = req.Username
-    usersByName[u.Username] = u
-    mu.Unlock()
+    if u.Username != "" {
+        s.mu.Unlock()
+        slog.Warn("User already registered", "userID", u.ID, "username", u.Username)
+        writeError(w, http.StatusConflict, "already_registered", "")
+        return
+    }
+    u.Username, u.passwordHash = req.Username, hash
+    err = s.store.SaveUser(u)
+    if err != nil {
+        u.Username, u.passwordHash = "", nil
+    }
+    s.mu.Unlock()
+    if errors.Is(err, ErrUsernameTaken) {
+        slog.Warn("Username already exists", "username", req.Username)
+        w.Header().Set("Content-Type", "application/json")
+        w.WriteHeader(http.StatusConflict)
+        _ = json.NewEncoder(w).Encode(api.Error{Error: "username_exists"})
+        return
+    }
+    if err != nil {
+        slog.Error("Failed to save user", "userID", u.ID, "error", err)
+        http.Error(w, "internal error", http.StatusInternalServerError)
+        return
+    }
 
-    tok, _ := issueJWT(u, registeredTTL)
+    sess, err := s.startSession(u)
+    if err != nil {
+        slog.Error("Failed to start session", "userID", u.ID, "error", err)
+        http.Error(w, "internal error", http.StatusInternalServerError)
+        return
+    }
     w.Header().Set("Content-Type", "application/json")
     w.WriteHeader(http.StatusCreated)
-    _ = json.NewEncoder(w).Encode(api.AuthResponse{Token: tok})
-    slog.Info("User registered", "userID", u.ID, "username", u.Username)
+    _ = json.NewEncoder(w).Encode(s.authResponse(sess))
+    slog.Info("User registered", "userID", u.ID, "username", req.Username)
 }
 
-// POST /login — demo: username only, no password DB
+// POST /login
 func (s *Server) PostLogin(w http.ResponseWriter, r *http.Request) {
     slog.Info("Handling POST /login")
     var req api.LoginRequest
@@ -306,20 +550,152 @@ func (s *Server) PostLogin(w http.ResponseWriter, r *http.Request) {
         http.Error(w, err.Error(), http.StatusBadRequest)
         return
     }
-    mu.RLock()
-    u := usersByName[req.Username]
-    mu.RUnlock()
-    if u == nil {
+    u, err := s.store.UserByName(req.Username)
+    if err != nil {
+        slog.Error("Failed to load user", "username", req.Username, "error", err)
+        http.Error(w, "internal error", http.StatusInternalServerError)
+        return
+    }
+    var hash []byte
+    if u != nil {
+        s.mu.RLock()
+        hash = u.passwordHash
+        s.mu.RUnlock()
+    }
+    // unknown users still pay for a bcrypt compare, see verifyPassword
+    if !verifyPassword(hash, req.Password) {
         slog.Warn("Invalid login credentials", "username", req.Username)
         w.Header().Set("Content-Type", "application/json")
         w.WriteHeader(http.StatusUnauthorized)
         _ = json.NewEncoder(w).Encode(api.Error{Error: "invalid_credentials"})
         return
     }
-    tok, _ := issueJWT(u, registeredTTL)
+    sess, err := s.startSession(u)
+    if err != nil {
+        slog.Error("Failed to start session", "userID", u.ID, "error", err)
+        http.Error(w, "internal error", http.StatusInternalServerError)
+        return
+    }
     w.Header().Set("Content-Type", "application/json; charset=utf-8")
-    _ = json.NewEncoder(w).Encode(api.AuthResponse{Token: tok})
-    slog.Info("User logged in", "userID", u.ID, "username", u.Username)
+    _ = json.NewEncoder(w).Encode(s.authResponse(sess))
+    slog.Info("User logged in", "userID", u.ID, "username", req.Username)
+}
+
+// POST /session/refresh
+func (s *Server) PostSessionRefresh(w http.ResponseWriter, r *http.Request) {
+    slog.Info("Handling POST /session/refresh")
+    var req api.RefreshRequest
+    if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+        slog.Error("Failed to decode request", "error", err)
+        http.Error(w, err.Error(), http.StatusBadRequest)
+        return
+    }
+    u, sess, err := s.refreshSession(req.RefreshToken)
+    switch {
+    case errors.Is(err, errRefreshReused):
+        writeError(w, http.StatusUnauthorized, "refresh_token_reused", "")
+        return
+    case errors.Is(err, errRefreshInvalid):
+        slog.Warn("Invalid refresh token")
+        writeError(w, http.StatusUnauthorized, "invalid_refresh_token", "")
+        return
+    case err != nil:
+        slog.Error("Failed to refresh session", "error", err)
+        http.Error(w, "internal error", http.StatusInternalServerError)
+        return
+    }
+    w.Header().Set("Content-Type", "application/json; charset=utf-8")
+    _ = json.NewEncoder(w).Encode(s.authResponse(sess))
+    slog.Info("Session refreshed", "userID", u.ID, "family", sess.Family)
+}
+
+// POST /logout
+func (s *Server) PostLogout(w http.ResponseWriter, r *http.Request) {
+    slog.Info("Handling POST /logout")
+    var req api.RefreshRequest
+    if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+        slog.Error("Failed to decode request", "error", err)
+        http.Error(w, err.Error(), http.StatusBadRequest)
+        return
+    }
+    // outstanding access tokens die with the family; keep the entry that long
+    until := time.Now().Add(s.cfg.Auth.AccessTokenTTL)
+    if rt, err := s.store.RefreshToken(hashToken(req.RefreshToken)); err != nil {
+        slog.Error("Failed to load refresh token", "error", err)
+    } else if rt != nil {
+        if err := s.store.RevokeFamily(rt.Family, until); err != nil {
+            slog.Error("Failed to revoke token family", "family", rt.Family, "error", err)
+            http.Error(w, "internal error", http.StatusInternalServerError)
+            return
+        }
+        slog.Info("Token family revoked", "userID", rt.UserID, "family", rt.Family)
+    }
+    if bearer := r.Header.Get("Authorization"); strings.HasPrefix(bearer, "Bearer ") {
+        if claims, err := s.parseJWT(strings.TrimPrefix(bearer, "Bearer ")); err == nil {
+            jti, _ := claims["jti"].(string)
+            if err := s.store.RevokeToken(jti, until); err != nil {
+                slog.Error("Failed to revoke access token", "jti", jti, "error", err)
+            }
+        }
+    }
+    w.WriteHeader(http.StatusNoContent)
+}
+
+// POST /account/password
+func (s *Server) PostAccountPassword(w http.ResponseWriter, r *http.Request) {
+    slog.Info("Handling POST /account/password")
+    bearer := r.Header.Get("Authorization")
+    if !strings.HasPrefix(bearer, "Bearer ") {
+        slog.Warn("Missing token in request")
+        writeError(w, http.StatusUnauthorized, "missing_token", "")
+        return
+    }
+    u, err := s.userFromJWT(strings.TrimPrefix(bearer, "Bearer "))
+    if err != nil || u == nil {
+        slog.Warn("Invalid token", "error", err)
+        writeError(w, http.StatusUnauthorized, "invalid_token", "")
+        return
+    }
+    var req api.ChangePasswordRequest
+    if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+        slog.Error("Failed to decode request", "error", err)
+        http.Error(w, err.Error(), http.StatusBadRequest)
+        return
+    }
+
+    s.mu.RLock()
+    username, hash := u.Username, u.passwordHash
+    s.mu.RUnlock()
+    if username == "" || !verifyPassword(hash, req.CurrentPassword) {
+        slog.Warn("Password change rejected", "userID", u.ID)
+        writeError(w, http.StatusUnauthorized, "invalid_credentials", "")
+        return
+    }
+    if err := checkPasswordPolicy(username, req.NewPassword); err != nil {
+        writeError(w, http.StatusBadRequest, "weak_password", err.Error())
+        return
+    }
+    newHash, err := hashPassword(req.NewPassword)
+    if err != nil {
+        slog.Error("Failed to hash password", "error", err)
+        http.Error(w, "internal error", http.StatusInternalServerError)
+        return
+    }
+
+    s.mu.Lock()
+    u.passwordHash = newHash
+    err = s.store.SaveUser(u)
+    if err != nil {
+        u.passwordHash = hash
+    }
+    s.mu.Unlock()
+    if err != nil {
+        slog.Error("Failed to save user", "userID", u.ID, "error", err)
+        http.Error(w, "internal error", http.StatusInternalServerError)
+        return
+    }
+    w.WriteHeader(http.StatusNoContent)
+    slog.Info("Password changed", "userID", u.ID)
 }
 
 // GET /me
@@ -331,15 +707,24 @@ func (s *Server) GetMe(w http.ResponseWriter, r *http.Request) {
         http.Error(w, "missing token", http.StatusUnauthorized)
         return
     }
-    u, err := userFromJWT(strings.TrimPrefix(bearer, "Bearer "))
+    u, err := s.userFromJWT(strings.TrimPrefix(bearer, "Bearer "))
     if err != nil || u == nil {
         slog.Warn("Invalid token", "error", err)
         http.Error(w, "invalid token", http.StatusUnauthorized)
         return
     }
     w.Header().Set("Content-Type", "application/json; charset=utf-8")
-    _ = json.NewEncoder(w).Encode(api.User{Id: u.ID, Username: u.Username})
-    slog.Info("User info retrieved", "userID", u.ID, "username", u.Username)
+    username := s.usernameOf(u)
+    _ = json.NewEncoder(w).Encode(api.User{Id: u.ID, Username: username})
+    slog.Info("User info retrieved", "userID", u.ID, "username", username)
+}
+
+// GET /.well-known/jwks.json
+func (s *Server) GetWellKnownJwksJson(w http.ResponseWriter, r *http.Request) {
+    slog.Info("Handling GET /.well-known/jwks.json")
+    w.Header().Set("Content-Type", "application/json; charset=utf-8")
+    w.Header().Set("Cache-Control", "public, max-age=300")
+    _ = json.NewEncoder(w).Encode(s.keys.jwks())
 }
 
 // GET /ping
@@ -358,16 +743,16 @@ func (s *Server) PostSessionSkip(w http.ResponseWriter, r *http.Request) {
         http.Error(w, "", http.StatusUnauthorized)
         return
     }
-    u, err := userFromJWT(strings.TrimPrefix(bearer, "Bearer "))
+    u, err := s.userFromJWT(strings.TrimPrefix(bearer, "Bearer "))
     if err != nil || u == nil {
         slog.Warn("Invalid token", "error", err)
         http.Error(w, "", http.StatusUnauthorized)
         return
     }
 
-    mu.Lock()
-    if time.Since(u.lastSkipTime) < skipCooldown {
-        mu.Unlock()
+    s.mu.Lock()
+    if time.Since(u.lastSkipTime) < s.cfg.Chat.SkipCooldown {
+        s.mu.Unlock()
         slog.Warn("Skip rate limited", "userID", u.ID)
         w.Header().Set("Content-Type", "application/json")
         w.WriteHeader(http.StatusTooManyRequests)
@@ -375,21 +760,15 @@ func (s *Server) PostSessionSkip(w http.ResponseWriter, r *http.Request) {
         return
     }
     u.lastSkipTime = time.Now()
-    for id, c := range conversations {
-        for i, p := range c.Participants {
-            if p == u {
-                c.Participants = append(c.Participants[:i], c.Participants[i+1:]...)
-            }
-        }
-        if len(c.Participants) < 2 {
-            c.timer.Stop()
-            delete(conversations, id)
-            waitingQueue = append(waitingQueue, c.Participants...)
-        }
+    if u.away != nil {
+        u.away.Stop() // skipped while offline; the seat is gone already
+        u.away = nil
     }
-    waitingQueue = append(waitingQueue, u)
-    mu.Unlock()
-    tryPair()
+    s.leaveConversations(u, api.ReasonPartnerSkipped)
+    s.store.Dequeue(u)
+    s.enqueue(u)
+    s.mu.Unlock()
+    s.tryPair()
     w.WriteHeader(http.StatusNoContent)
     slog.Info("User skipped session", "userID", u.ID)
 }
@@ -397,35 +776,113 @@ func (s *Server) PostSessionSkip(w http.ResponseWriter, r *http.Request) {
 // GET /ws/chat
 func (s *Server) GetWsChat(w http.ResponseWriter, r *http.Request, params api.GetWsChatParams) {
     slog.Info("Handling GET /ws/chat", "token", params.Token)
-    u, err := userFromJWT(params.Token)
+    u, err := s.userFromJWT(params.Token)
     if err != nil || u == nil {
         slog.Warn("Invalid token", "error", err)
         http.Error(w, "invalid token", http.StatusUnauthorized)
         return
     }
+    interests, language, err := matchPrefs(api.AnonymousSessionRequest{Interests: params.Interests, Language: params.Language})
+    if err != nil {
+        slog.Warn("Rejected match preferences", "userID", u.ID, "error", err)
+        writeError(w, http.StatusBadRequest, "invalid_interests", err.Error())
+        return
+    }
+    if !s.track() {
+        rejectDraining(w)
+        return
+    }
     upgrader := websocket.Upgrader{CheckOrigin: func(_ *http.Request) bool { return true }}
     conn, err := upgrader.Upgrade(w, r, nil)
     if err != nil {
         slog.Error("Failed to upgrade connection", "error", err)
+        s.wg.Done()
+        return
+    }
+    conn.SetReadLimit(s.cfg.WS.MaxFrameBytes)
+    c := newClient(conn, u.ID, s.cfg.WS)
+    go c.writePump()
+    s.mu.Lock()
+    if s.draining {
+        // upgraded after Shutdown took its list of sockets; turn it away
+        // the same way, or its pings would hold the drain open
+        s.mu.Unlock()
+        c.restarting()
+        <-c.done // the writer stops after the close frame or its write timeout
+        conn.Close()
+        s.wg.Done()
+        slog.Info("WebSocket turned away while draining", "userID", u.ID)
         return
     }
-    u.conn = conn
+    if old := u.conn; old != nil {
+        old.close(websocket.CloseGoingAway, "replaced by a newer connection")
+    }
+    u.conn = c
+    u.lastQueued = nil // a new socket hears the queue status afresh
+    if params.Interests != nil {
+        u.interests = interests
+    }
+    if params.Language != nil {
+        u.language = language
+    }
+    s.connected[u.ID] = u
+    // back within the grace window, whether or not the round survived; left
+    // armed, the timer would later evict a connected user
+    wasAway := u.away != nil
+    if wasAway {
+        u.away.Stop()
+        u.away = nil
+    }
+    if conv := s.conversationOf(u); conv != nil {
+        since := conv.seq
+        if wasAway {
+            since = u.awaySeq
+        }
+        if params.LastSeq != nil {
+            since = *params.LastSeq
+        }
+        s.resume(u, conv, since)
+    } else {
+        // back to the queue, e.g. after a dropped socket ended the last round
+        s.store.Dequeue(u)
+        s.enqueue(u)
+    }
+    s.mu.Unlock()
     slog.Info("WebSocket connection established", "userID", u.ID)
 
-    go tryPair()
+    go s.tryPair()
 
     go func() {
         defer func() {
+            s.mu.Lock()
+            current := u.conn == c
+            if current {
+                u.conn = nil
+                delete(s.connected, u.ID)
+                if !s.holdSeat(u) {
+                    s.leaveConversations(u, api.ReasonPartnerDisconnected) // don't leave the partner talking to a ghost
+                }
+                s.store.Dequeue(u)
+            }
+            s.mu.Unlock()
+            c.stop()
             conn.Close()
-            u.conn = nil
+            if current {
+                s.tryPair()
+            }
+            s.wg.Done()
             slog.Info("WebSocket connection closed", "userID", u.ID)
         }()
+        presence := newLimiter(s.cfg.Chat.PresenceRate, s.cfg.Chat.PresenceBurst)
         for {
-            var msg api.ChatMessage
-            err := conn.ReadJSON(&msg)
+            kind, data, err := conn.ReadMessage()
             if err != nil {
                 // If the client closed normally (EOF, close frame, going away), log at Info
-                if websocket.IsUnexpectedCloseError(err, websocket.CloseAbnormalClosure) {
+                if errors.Is(err, websocket.ErrReadLimit) {
+                    slog.Warn("WebSocket frame over the read limit; closed with 1009", "userID", u.ID, "limit", s.cfg.WS.MaxFrameBytes)
+                } else if ne, ok := err.(net.Error); ok && ne.Timeout() {
+                    slog.Warn("WebSocket peer idle; declaring it dead", "userID", u.ID, "idleTimeout", s.cfg.WS.IdleTimeout)
+                } else if websocket.IsUnexpectedCloseError(err, websocket.CloseAbnormalClosure) {
                     // truly unexpected
                     slog.Warn("WebSocket read error", "userID", u.ID, "error", err)
                 } else {
@@ -434,20 +891,62 @@ func (s *Server) GetWsChat(w http.ResponseWriter, r *http.Request, params api.Ge
                 }
                 return
             }
+            c.keepAlive()
+            msg, bad := s.decodeFrame(kind, data)
+            if bad != nil {
+                slog.Warn("Rejected frame", "userID", u.ID, "code", *bad.Code)
+                c.send(*bad)
+                continue
+            }
+            if isPresence(msg.Type) && !presence.allow() {
+                slog.Debug("Presence signal rate limited", "userID", u.ID, "type", msg.Type)
+                c.send(*errorEvent(msg.ConversationId, api.RateLimited, ""))
+                continue
+            }
             now := time.Now().UTC()
             msg.Timestamp = &now
-            mu.RLock()
-            conv := conversations[msg.ConversationId]
-            mu.RUnlock()
-            if conv == nil {
-                slog.Warn("Conversation not found", "conversationID", msg.ConversationId)
+            msg.From = &u.ID
+            // relay under the lock so delivery order matches seq order
+            s.mu.Lock()
+            conv := s.store.Conversation(msg.ConversationId)
+            if conv == nil || !conv.has(u) {
+                s.mu.Unlock()
+                slog.Warn("Frame for a conversation the user is not in", "userID", u.ID, "conversationID", msg.ConversationId)
+                c.send(*errorEvent(msg.ConversationId, api.NotInConversation, ""))
+                continue
+            }
+            if msg.Type == api.ExtendRequest || msg.Type == api.ExtendAccept {
+                s.voteExtend(conv, u, msg)
+                s.mu.Unlock()
+                continue
+            }
+            if msg.Type == api.Connect {
+                s.voteConnect(conv, u, msg)
+                s.mu.Unlock()
+                continue
+            }
+            if msg.Type == api.Chat {
+                if !s.moderate(conv, u, &msg) {
+                    s.mu.Unlock()
+                    continue
+                }
+                ack, dup := s.acceptChat(conv, u, &msg)
+                c.send(ack)
+                if !dup {
+                    for _, p := range conv.Participants {
+                        s.sendChat(p, u, msg)
+                    }
+                }
+                s.mu.Unlock()
                 continue
             }
             for _, p := range conv.Participants {
-                if p.conn != nil {
-                    _ = p.conn.WriteJSON(msg)
+                if p.conn == nil || (p == u && partnerOnly(msg.Type)) {
+                    continue // presence and read receipts are for the partner only
                 }
+                p.conn.send(msg)
             }
+            s.mu.Unlock()
         }
     }()
 }
@@ -0,0 +1,48 @@
+// backend/ops/presence.go
+// Ephemeral presence signals: typing, stopped_typing, away, back. They go to
+// the partner only, are never recorded for replay, and each connection gets
+// a small token bucket so a chatty client cannot flood its partner.
+
+package ops
+
+import (
+	"time"
+
+	"backend/api"
+)
+
+func isPresence(t api.ChatMessageType) bool {
+	switch t {
+	case api.Typing, api.StoppedTyping, api.Away, api.Back:
+		return true
+	}
+	return false
+}
+
+// limiter is a token bucket. It is not safe for concurrent use; each one
+// belongs to a single reader goroutine.
+type limiter struct {
+	rate   float64 // tokens per second
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newLimiter(rate float64, burst int) *limiter {
+	return &limiter{rate: rate, burst: float64(burst), tokens: float64(burst), last: time.Now()}
+}
+
+// allow takes a token if one is available.
+func (l *limiter) allow() bool {
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+	if l.tokens < 1 {
+		return false
+	}
+	l.tokens--
+	return true
+}
@@ -0,0 +1,163 @@
+// backend/ops/queue.go
+// Queue feedback for waiting users: their position, how many people are
+// online and a rough wait estimate from the recent pairing rate. Connected
+// users get queued events when those numbers change noticeably; everyone
+// else can poll GET /queue/status.
+
+package ops
+
+import (
+	"encoding/json"
+	"log/slog"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"backend/api"
+)
+
+const (
+	pairRateWindow  = 5 * time.Minute // pairings older than this don't count
+	pairRateSamples = 64              // …and only this many recent ones
+)
+
+// notePair records a pairing for the wait estimate. The caller holds s.mu.
+func (s *Server) notePair(at time.Time) {
+	s.pairings = append(s.pairings, at)
+	cut := 0
+	for cut < len(s.pairings) && (at.Sub(s.pairings[cut]) > pairRateWindow || len(s.pairings)-cut > pairRateSamples) {
+		cut++
+	}
+	s.pairings = append(s.pairings[:0], s.pairings[cut:]...)
+}
+
+// queueView is one consistent look at the queue.
+type queueView struct {
+	online  int
+	queue   []*user // distinct, front first
+	waiting []*user // the connected part of queue
+	rate    float64 // users paired per second; 0 if unknown
+}
+
+// viewQueue snapshots the queue. The caller holds s.mu (read is enough).
+func (s *Server) viewQueue(now time.Time) queueView {
+	v := queueView{online: len(s.connected)}
+	seen := map[*user]bool{}
+	for _, u := range s.store.Queue() {
+		if seen[u] {
+			continue
+		}
+		seen[u] = true
+		v.queue = append(v.queue, u)
+		if u.conn != nil {
+			v.waiting = append(v.waiting, u)
+		}
+	}
+	var recent int
+	var oldest time.Time
+	for _, t := range s.pairings {
+		if now.Sub(t) <= pairRateWindow {
+			if recent == 0 {
+				oldest = t
+			}
+			recent++
+		}
+	}
+	if recent >= 2 {
+		span := math.Max(now.Sub(oldest).Seconds(), 1)
+		v.rate = float64(s.cfg.Chat.GroupSize*recent) / span
+	}
+	return v
+}
+
+// position is u's 1‑based place in the queue, or 0. Only connected users
+// ahead count, so a user who has not opened their socket yet still learns
+// where they will stand.
+func (v queueView) position(u *user) int {
+	ahead := 0
+	for _, q := range v.queue {
+		if q == u {
+			return ahead + 1
+		}
+		if q.conn != nil {
+			ahead++
+		}
+	}
+	return 0
+}
+
+func (v queueView) status(pos int) api.QueueStatus {
+	st := api.QueueStatus{Online: v.online, Waiting: len(v.waiting)}
+	if pos > 0 {
+		st.Position = &pos
+		if v.rate > 0 {
+			wait := int(math.Ceil(float64(pos) / v.rate))
+			st.EstimatedWaitSeconds = &wait
+		}
+	}
+	return st
+}
+
+// noticeable reports whether a waiting user should hear about next, having
+// last been told prev: a new position, a ≥10% swing in people online, or a
+// wait estimate that moved by at least 10 s and 20%.
+func noticeable(prev *api.QueueStatus, next api.QueueStatus) bool {
+	if prev == nil || *prev.Position != *next.Position {
+		return true
+	}
+	if d := next.Online - prev.Online; d != 0 && abs(d)*10 >= prev.Online {
+		return true
+	}
+	pw, nw := prev.EstimatedWaitSeconds, next.EstimatedWaitSeconds
+	if (pw == nil) != (nw == nil) {
+		return true
+	}
+	if pw != nil {
+		d := abs(*nw - *pw)
+		return d >= 10 && d*5 >= *pw
+	}
+	return false
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// notifyQueue sends queued events to waiting users whose status changed
+// noticeably. The caller holds s.mu.
+func (s *Server) notifyQueue() {
+	v := s.viewQueue(time.Now())
+	for i, u := range v.waiting {
+		st := v.status(i + 1)
+		if !noticeable(u.lastQueued, st) {
+			continue
+		}
+		u.lastQueued = &st
+		u.conn.send(api.ChatMessage{Type: api.Queued, Queue: &st})
+	}
+}
+
+// GET /queue/status
+func (s *Server) GetQueueStatus(w http.ResponseWriter, r *http.Request) {
+	slog.Info("Handling GET /queue/status")
+	var u *user
+	if bearer := r.Header.Get("Authorization"); strings.HasPrefix(bearer, "Bearer ") {
+		var err error
+		u, err = s.userFromJWT(strings.TrimPrefix(bearer, "Bearer "))
+		if err != nil || u == nil {
+			slog.Warn("Invalid token", "error", err)
+			writeError(w, http.StatusUnauthorized, "invalid_token", "")
+			return
+		}
+	}
+	s.mu.RLock()
+	v := s.viewQueue(time.Now())
+	st := v.status(v.position(u))
+	s.mu.RUnlock()
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	_ = json.NewEncoder(w).Encode(st)
+}
@@ -0,0 +1,87 @@
+// backend/ops/receipts.go
+// Message IDs and receipts. Every chat message gets a server ID; the sender
+// gets an ack right away and a delivered receipt per member whose socket
+// accepted the frame. Clients may tag messages with their own clientMsgId so
+// a resend after a flaky network is acknowledged again instead of relayed
+// twice. Read receipts come from clients and only go to the partner.
+
+package ops
+
+import (
+	"log/slog"
+	"time"
+
+	"backend/api"
+)
+
+// partnerOnly event types are relayed to everyone in the conversation
+// except their sender.
+func partnerOnly(t api.ChatMessageType) bool {
+	return isPresence(t) || t == api.Read
+}
+
+func dedupeKey(from *user, clientMsgID string) string {
+	return from.ID + "\x00" + clientMsgID
+}
+
+// acceptChat stamps a new chat message from u with its ID and seq and returns
+// the ack for the sender. dup is true if u already sent this clientMsgId in
+// conv; the message must then not be relayed and ack is the original one.
+// The caller holds s.mu.
+func (s *Server) acceptChat(conv *conversation, u *user, msg *api.ChatMessage) (ack api.ChatMessage, dup bool) {
+	if msg.ClientMsgId != nil && *msg.ClientMsgId != "" {
+		if prev, ok := conv.acks[dedupeKey(u, *msg.ClientMsgId)]; ok {
+			slog.Info("Duplicate chat message", "userID", u.ID, "clientMsgId", *msg.ClientMsgId, "id", *prev.Id)
+			return prev, true
+		}
+	}
+	id := genID()
+	msg.Id = &id
+	conv.record(u, msg, s.cfg.Chat.ReplayBuffer)
+	ack = api.ChatMessage{
+		Type:           api.Ack,
+		ConversationId: conv.ID,
+		Id:             msg.Id,
+		ClientMsgId:    msg.ClientMsgId,
+		Seq:            msg.Seq,
+		Timestamp:      msg.Timestamp,
+	}
+	if msg.ClientMsgId != nil && *msg.ClientMsgId != "" {
+		if conv.acks == nil {
+			conv.acks = map[string]api.ChatMessage{}
+		}
+		conv.acks[dedupeKey(u, *msg.ClientMsgId)] = ack
+	}
+	return ack, false
+}
+
+// sendChat queues a chat message from `from` for `to`. Once to's socket has
+// taken it, the sender hears about it with a delivered receipt; its own
+// echo needs none. The caller holds s.mu.
+func (s *Server) sendChat(to, from *user, msg api.ChatMessage) {
+	if to.conn == nil {
+		return
+	}
+	if to == from {
+		to.conn.send(msg)
+		return
+	}
+	to.conn.sendThen(msg, func() {
+		// runs on to's writer goroutine, without s.mu
+		now := time.Now().UTC()
+		receipt := api.ChatMessage{
+			Type:           api.Delivered,
+			ConversationId: msg.ConversationId,
+			Id:             msg.Id,
+			ClientMsgId:    msg.ClientMsgId,
+			From:           &to.ID,
+			Timestamp:      &now,
+		}
+		s.mu.RLock()
+		c := from.conn
+		s.mu.RUnlock()
+		if c != nil {
+			c.send(receipt)
+		}
+	})
+}
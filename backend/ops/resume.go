@@ -0,0 +1,104 @@
+// backend/ops/resume.go
+// Resuming after a dropped socket. A user who disconnects mid‑round keeps
+// their seat for chat.resumeGrace; reconnecting within it re‑attaches them
+// to the conversation and replays the chat messages they missed from a small
+// per‑conversation buffer. Every chat message carries a sequence number so
+// the client can say what it has already seen.
+
+package ops
+
+import (
+	"log/slog"
+	"time"
+
+	"backend/api"
+)
+
+// logged is a chat message kept for replay, with its sender so a replay can
+// still produce delivered receipts.
+type logged struct {
+	msg  api.ChatMessage
+	from *user
+}
+
+// record numbers a chat message and keeps the last keep of them for replay.
+// The caller holds Server.mu.
+func (c *conversation) record(from *user, msg *api.ChatMessage, keep int) {
+	c.seq++
+	seq := c.seq
+	msg.Seq = &seq
+	if keep == 0 {
+		return
+	}
+	c.history = append(c.history, logged{msg: *msg, from: from})
+	if len(c.history) > keep {
+		c.history = c.history[len(c.history)-keep:]
+	}
+}
+
+// conversationOf returns the active conversation u takes part in, or nil.
+// The caller holds s.mu.
+func (s *Server) conversationOf(u *user) *conversation {
+	for _, c := range s.store.Conversations() {
+		if c.has(u) {
+			return c
+		}
+	}
+	return nil
+}
+
+// holdSeat starts u's grace window if u just dropped out of a live
+// conversation and reports whether it did. If u has not reconnected when the
+// window closes, u leaves the conversation for good. The caller holds s.mu.
+func (s *Server) holdSeat(u *user) bool {
+	conv := s.conversationOf(u)
+	if conv == nil || s.cfg.Chat.ResumeGrace <= 0 {
+		return false
+	}
+	u.awaySeq = conv.seq
+	var t *time.Timer
+	t = time.AfterFunc(s.cfg.Chat.ResumeGrace, func() {
+		if !s.track() {
+			return // shutting down; Shutdown ends the conversation
+		}
+		defer s.wg.Done()
+		s.mu.Lock()
+		if u.away != t {
+			s.mu.Unlock()
+			return // reconnected in time
+		}
+		u.away = nil
+		slog.Info("Resume grace expired", "userID", u.ID, "conversationID", conv.ID)
+		s.leaveConversations(u, api.ReasonPartnerDisconnected)
+		s.store.Dequeue(u)
+		s.mu.Unlock()
+		s.tryPair()
+	})
+	u.away = t
+	slog.Info("Holding seat for dropped user", "userID", u.ID, "conversationID", conv.ID, "grace", s.cfg.Chat.ResumeGrace)
+	return true
+}
+
+// resume attaches u's new socket to conv: a resumed event with the round's
+// end, then every buffered chat message after since, in order. The caller
+// holds s.mu, so no live message can overtake the replay.
+func (s *Server) resume(u *user, conv *conversation, since int64) {
+	now := time.Now().UTC()
+	expiresAt := conv.expiresAt
+	members := conv.members()
+	u.conn.send(api.ChatMessage{
+		Type:           api.Resumed,
+		ConversationId: conv.ID,
+		Timestamp:      &now,
+		ExpiresAt:      &expiresAt,
+		Members:        &members,
+	})
+	replayed := 0
+	for _, l := range conv.history {
+		if *l.msg.Seq > since {
+			s.sendChat(u, l.from, l.msg)
+			replayed++
+		}
+	}
+	slog.Info("Conversation resumed", "userID", u.ID, "conversationID", conv.ID, "since", since, "replayed", replayed)
+}
@@ -0,0 +1,377 @@
+// backend/ops/sqlite.go
+// Embedded SQLite Store. Registered accounts and conversation history are
+// written through to disk; anonymous users, the waiting queue and live
+// conversations stay in memory because they are tied to open sockets.
+
+package ops
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	_ "modernc.org/sqlite" // pure‑Go driver, keeps CGO_ENABLED=0 builds working
+)
+
+// migrations are applied in order; PRAGMA user_version records how many ran.
+// Never edit an entry once it has shipped — append a new one instead.
+var migrations = []string{
+	// 1: accounts + conversation history
+	`CREATE TABLE users (
+		id         TEXT PRIMARY KEY,
+		username   TEXT NOT NULL UNIQUE,
+		created_at INTEGER NOT NULL
+	);
+	CREATE TABLE conversations (
+		id         TEXT PRIMARY KEY,
+		started_at INTEGER NOT NULL,
+		expires_at INTEGER NOT NULL,
+		ended_at   INTEGER
+	);
+	CREATE TABLE conversation_participants (
+		conversation_id TEXT NOT NULL REFERENCES conversations(id) ON DELETE CASCADE,
+		user_id         TEXT NOT NULL,
+		PRIMARY KEY (conversation_id, user_id)
+	);
+	CREATE INDEX conversation_participants_user ON conversation_participants(user_id);`,
+
+	// 2: bcrypt password hashes (NULL for accounts that predate passwords)
+	`ALTER TABLE users ADD COLUMN password_hash BLOB;`,
+
+	// 3: rotating refresh tokens + access‑token revocation list
+	`CREATE TABLE refresh_tokens (
+		hash       TEXT PRIMARY KEY,
+		family     TEXT NOT NULL,
+		user_id    TEXT NOT NULL,
+		expires_at INTEGER NOT NULL,
+		used       INTEGER NOT NULL DEFAULT 0
+	);
+	CREATE INDEX refresh_tokens_family ON refresh_tokens(family);
+	CREATE TABLE revocations (
+		kind       TEXT NOT NULL, -- 'jti' or 'family'
+		id         TEXT NOT NULL,
+		expires_at INTEGER NOT NULL,
+		PRIMARY KEY (kind, id)
+	);`,
+
+	// 4: contacts made by mutual connect, one row per direction
+	`CREATE TABLE contacts (
+		user_id    TEXT NOT NULL REFERENCES users(id),
+		contact_id TEXT NOT NULL REFERENCES users(id),
+		created_at INTEGER NOT NULL,
+		PRIMARY KEY (user_id, contact_id)
+	);`,
+
+	// 5: blocks and reports, keyed by user ID whether or not registered
+	`CREATE TABLE blocks (
+		blocker_id TEXT NOT NULL,
+		blocked_id TEXT NOT NULL,
+		created_at INTEGER NOT NULL,
+		PRIMARY KEY (blocker_id, blocked_id)
+	);
+	CREATE TABLE reports (
+		id              TEXT PRIMARY KEY,
+		conversation_id TEXT NOT NULL,
+		reporter_id     TEXT NOT NULL,
+		reported_id     TEXT NOT NULL,
+		reason          TEXT NOT NULL,
+		note            TEXT NOT NULL DEFAULT '',
+		created_at      INTEGER NOT NULL
+	);
+	CREATE INDEX reports_reported ON reports(reported_id);`,
+}
+
+type sqliteStore struct {
+	*memStore // identity cache + ephemeral state
+	db        *sql.DB
+}
+
+// OpenSQLite opens (creating if needed) the database at path and brings its
+// schema up to date.
+func OpenSQLite(path string) (Store, error) {
+	dsn := "file:" + path + "?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_pragma=foreign_keys(1)"
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("open sqlite %q: %w", path, err)
+	}
+	db.SetMaxOpenConns(1) // SQLite allows one writer; serialise in the pool
+	if err := migrate(db); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &sqliteStore{memStore: newMemStore(), db: db}, nil
+}
+
+func migrate(db *sql.DB) error {
+	var version int
+	if err := db.QueryRow(`PRAGMA user_version`).Scan(&version); err != nil {
+		return fmt.Errorf("read schema version: %w", err)
+	}
+	for i := version; i < len(migrations); i++ {
+		tx, err := db.Begin()
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(migrations[i]); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migration %d: %w", i+1, err)
+		}
+		// PRAGMA does not take bind parameters
+		if _, err := tx.Exec(fmt.Sprintf(`PRAGMA user_version = %d`, i+1)); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migration %d: %w", i+1, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("migration %d: %w", i+1, err)
+		}
+		slog.Info("Applied schema migration", "version", i+1)
+	}
+	return nil
+}
+
+// SaveUser persists registered users; anonymous ones only live in memory.
+func (s *sqliteStore) SaveUser(u *user) error {
+	if u.Username != "" {
+		_, err := s.db.Exec(`
+			INSERT INTO users (id, username, password_hash, created_at) VALUES (?, ?, ?, ?)
+			ON CONFLICT(id) DO UPDATE SET username = excluded.username, password_hash = excluded.password_hash`,
+			u.ID, u.Username, u.passwordHash, time.Now().Unix())
+		if isUniqueViolation(err) {
+			return ErrUsernameTaken
+		}
+		if err != nil {
+			return fmt.Errorf("save user %s: %w", u.ID, err)
+		}
+	}
+	return s.memStore.SaveUser(u)
+}
+
+func (s *sqliteStore) UserByID(id string) (*user, error) {
+	if u, _ := s.memStore.UserByID(id); u != nil {
+		return u, nil
+	}
+	return s.loadUser(`SELECT id, username, password_hash FROM users WHERE id = ?`, id)
+}
+
+func (s *sqliteStore) UserByName(username string) (*user, error) {
+	if u, _ := s.memStore.UserByName(username); u != nil {
+		return u, nil
+	}
+	return s.loadUser(`SELECT id, username, password_hash FROM users WHERE username = ?`, username)
+}
+
+func (s *sqliteStore) loadUser(query string, arg string) (*user, error) {
+	u := &user{}
+	err := s.db.QueryRow(query, arg).Scan(&u.ID, &u.Username, &u.passwordHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load user: %w", err)
+	}
+	if u.blocks, err = s.blocksOf(u.ID); err != nil {
+		return nil, err
+	}
+	return s.memStore.cacheUser(u), nil
+}
+
+func (s *sqliteStore) SaveConversation(c *conversation) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err := tx.Exec(`INSERT INTO conversations (id, started_at, expires_at) VALUES (?, ?, ?)
+		ON CONFLICT(id) DO UPDATE SET expires_at = excluded.expires_at`,
+		c.ID, time.Now().Unix(), c.expiresAt.Unix()); err != nil {
+		return fmt.Errorf("save conversation %s: %w", c.ID, err)
+	}
+	for _, p := range c.Participants {
+		if _, err := tx.Exec(`INSERT OR IGNORE INTO conversation_participants (conversation_id, user_id) VALUES (?, ?)`,
+			c.ID, p.ID); err != nil {
+			return fmt.Errorf("save conversation %s: %w", c.ID, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return s.memStore.SaveConversation(c)
+}
+
+func (s *sqliteStore) EndConversation(c *conversation) error {
+	_ = s.memStore.EndConversation(c)
+	if _, err := s.db.Exec(`UPDATE conversations SET ended_at = ? WHERE id = ? AND ended_at IS NULL`,
+		time.Now().Unix(), c.ID); err != nil {
+		return fmt.Errorf("end conversation %s: %w", c.ID, err)
+	}
+	return nil
+}
+
+func (s *sqliteStore) AddContact(a, b contact) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for _, pair := range [][2]string{{a.UserID, b.UserID}, {b.UserID, a.UserID}} {
+		if _, err := tx.Exec(`INSERT OR IGNORE INTO contacts (user_id, contact_id, created_at) VALUES (?, ?, ?)`,
+			pair[0], pair[1], a.Since.Unix()); err != nil {
+			return fmt.Errorf("add contact: %w", err)
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *sqliteStore) Contacts(userID string) ([]contact, error) {
+	rows, err := s.db.Query(`SELECT u.id, u.username, c.created_at FROM contacts c
+		JOIN users u ON u.id = c.contact_id
+		WHERE c.user_id = ? ORDER BY c.created_at, u.username`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list contacts: %w", err)
+	}
+	defer rows.Close()
+	var out []contact
+	for rows.Next() {
+		var c contact
+		var since int64
+		if err := rows.Scan(&c.UserID, &c.Username, &since); err != nil {
+			return nil, fmt.Errorf("list contacts: %w", err)
+		}
+		c.Since = time.Unix(since, 0)
+		out = append(out, c)
+	}
+	return out, rows.Err()
+}
+
+func (s *sqliteStore) Block(blockerID, blockedID string, at time.Time) error {
+	if _, err := s.db.Exec(`INSERT OR IGNORE INTO blocks (blocker_id, blocked_id, created_at) VALUES (?, ?, ?)`,
+		blockerID, blockedID, at.Unix()); err != nil {
+		return fmt.Errorf("block: %w", err)
+	}
+	return nil
+}
+
+// blocksOf loads the IDs userID has blocked, or nil if none.
+func (s *sqliteStore) blocksOf(userID string) (map[string]bool, error) {
+	rows, err := s.db.Query(`SELECT blocked_id FROM blocks WHERE blocker_id = ?`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("load blocks: %w", err)
+	}
+	defer rows.Close()
+	var out map[string]bool
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("load blocks: %w", err)
+		}
+		if out == nil {
+			out = map[string]bool{}
+		}
+		out[id] = true
+	}
+	return out, rows.Err()
+}
+
+func (s *sqliteStore) SaveReport(r report) error {
+	if _, err := s.db.Exec(`INSERT INTO reports (id, conversation_id, reporter_id, reported_id, reason, note, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		r.ID, r.ConversationID, r.ReporterID, r.ReportedID, r.Reason, r.Note, r.At.Unix()); err != nil {
+		return fmt.Errorf("save report: %w", err)
+	}
+	return nil
+}
+
+func (s *sqliteStore) SaveRefreshToken(t *refreshToken) error {
+	now := time.Now().Unix()
+	if _, err := s.db.Exec(`DELETE FROM refresh_tokens WHERE expires_at < ?`, now); err != nil {
+		return fmt.Errorf("prune refresh tokens: %w", err)
+	}
+	if _, err := s.db.Exec(`INSERT INTO refresh_tokens (hash, family, user_id, expires_at, used) VALUES (?, ?, ?, ?, ?)`,
+		t.Hash, t.Family, t.UserID, t.ExpiresAt.Unix(), t.Used); err != nil {
+		return fmt.Errorf("save refresh token: %w", err)
+	}
+	return nil
+}
+
+func (s *sqliteStore) RefreshToken(hash string) (*refreshToken, error) {
+	t := &refreshToken{Hash: hash}
+	var exp int64
+	err := s.db.QueryRow(`SELECT family, user_id, expires_at, used FROM refresh_tokens WHERE hash = ?`, hash).
+		Scan(&t.Family, &t.UserID, &exp, &t.Used)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load refresh token: %w", err)
+	}
+	t.ExpiresAt = time.Unix(exp, 0)
+	return t, nil
+}
+
+func (s *sqliteStore) UseRefreshToken(hash string) (bool, error) {
+	res, err := s.db.Exec(`UPDATE refresh_tokens SET used = 1 WHERE hash = ? AND used = 0`, hash)
+	if err != nil {
+		return false, fmt.Errorf("use refresh token: %w", err)
+	}
+	n, err := res.RowsAffected()
+	return n == 1, err
+}
+
+func (s *sqliteStore) RevokeFamily(family string, until time.Time) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if _, err := tx.Exec(`DELETE FROM refresh_tokens WHERE family = ?`, family); err != nil {
+		return fmt.Errorf("revoke family %s: %w", family, err)
+	}
+	if err := revoke(tx, "family", family, until); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *sqliteStore) RevokeToken(jti string, until time.Time) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := revoke(tx, "jti", jti, until); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func revoke(tx *sql.Tx, kind, id string, until time.Time) error {
+	if _, err := tx.Exec(`DELETE FROM revocations WHERE expires_at < ?`, time.Now().Unix()); err != nil {
+		return fmt.Errorf("prune revocations: %w", err)
+	}
+	if _, err := tx.Exec(`INSERT INTO revocations (kind, id, expires_at) VALUES (?, ?, ?)
+		ON CONFLICT(kind, id) DO UPDATE SET expires_at = MAX(expires_at, excluded.expires_at)`,
+		kind, id, until.Unix()); err != nil {
+		return fmt.Errorf("revoke %s %s: %w", kind, id, err)
+	}
+	return nil
+}
+
+func (s *sqliteStore) IsRevoked(jti, family string) (bool, error) {
+	var n int
+	err := s.db.QueryRow(`SELECT COUNT(*) FROM revocations
+		WHERE ((kind = 'jti' AND id = ?) OR (kind = 'family' AND id = ?)) AND expires_at >= ?`,
+		jti, family, time.Now().Unix()).Scan(&n)
+	if err != nil {
+		return false, fmt.Errorf("check revocation: %w", err)
+	}
+	return n > 0, nil
+}
+
+func (s *sqliteStore) Close() error { return s.db.Close() }
+
+func isUniqueViolation(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "UNIQUE constraint failed")
+}
@@ -0,0 +1,327 @@
+// backend/ops/store.go
+// Persistence boundary for the ops package. Live state (WebSocket conns,
+// round timers) stays on the *user / *conversation values themselves; a Store
+// decides how those values are indexed and which parts survive a restart.
+
+package ops
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrUsernameTaken is returned by Store.SaveUser when another user already
+// owns the requested username.
+var ErrUsernameTaken = errors.New("username already taken")
+
+// contact is one side of a mutual connect between registered users.
+type contact struct {
+	UserID   string
+	Username string
+	Since    time.Time
+}
+
+// report is one user's complaint about another, for moderators.
+type report struct {
+	ID             string
+	ConversationID string
+	ReporterID     string
+	ReportedID     string
+	Reason         string
+	Note           string
+	At             time.Time
+}
+
+// Store indexes users, usernames, the waiting queue and active conversations.
+// Lookups return (nil, nil) when nothing matches. Implementations must be
+// safe for concurrent use.
+type Store interface {
+	// SaveUser inserts or updates u, including its username index entry.
+	SaveUser(u *user) error
+	UserByID(id string) (*user, error)
+	UserByName(username string) (*user, error)
+
+	// Enqueue appends users to the back of the waiting queue.
+	Enqueue(us ...*user)
+	// Dequeue removes every queue entry for u.
+	Dequeue(u *user)
+	// Queue returns a snapshot of the waiting queue, front first.
+	Queue() []*user
+
+	SaveConversation(c *conversation) error
+	Conversation(id string) *conversation
+	Conversations() []*conversation
+	// EndConversation removes c from the active set.
+	EndConversation(c *conversation) error
+
+	// AddContact makes a and b contacts of each other; a.Since is kept.
+	// Adding an existing pair again changes nothing.
+	AddContact(a, b contact) error
+	// Contacts lists the contacts of a user, oldest first.
+	Contacts(userID string) ([]contact, error)
+
+	// Block records that blocker never wants to meet blocked again. Users
+	// returned by the store carry the IDs they blocked.
+	Block(blockerID, blockedID string, at time.Time) error
+	SaveReport(r report) error
+
+	SaveRefreshToken(t *refreshToken) error
+	RefreshToken(hash string) (*refreshToken, error)
+	// UseRefreshToken marks the token used and reports whether it was still
+	// unused; of several concurrent callers exactly one sees true.
+	UseRefreshToken(hash string) (bool, error)
+	// RevokeFamily deletes the family's refresh tokens and makes IsRevoked
+	// report its access tokens until the given time.
+	RevokeFamily(family string, until time.Time) error
+	// RevokeToken makes IsRevoked report the access token jti until the given time.
+	RevokeToken(jti string, until time.Time) error
+	IsRevoked(jti, family string) (bool, error)
+
+	Close() error
+}
+
+// ─── IN‑MEMORY STORE ───────────────────────────────────────────────────────
+
+type memStore struct {
+	mu            sync.RWMutex
+	usersByID     map[string]*user
+	usersByName   map[string]*user
+	nameByID      map[string]string // last indexed username, for renames
+	waitingQueue  []*user
+	conversations map[string]*conversation
+	contacts      map[string][]contact // by user ID, oldest first
+	reports       []report
+	refreshTokens map[string]refreshToken
+	revoked       map[string]time.Time // "jti:<id>" / "fam:<id>" → keep until
+}
+
+// NewMemoryStore returns the default Store; everything is lost on restart.
+func NewMemoryStore() Store { return newMemStore() }
+
+func newMemStore() *memStore {
+	return &memStore{
+		usersByID:     map[string]*user{},
+		usersByName:   map[string]*user{},
+		nameByID:      map[string]string{},
+		conversations: map[string]*conversation{},
+		contacts:      map[string][]contact{},
+		refreshTokens: map[string]refreshToken{},
+		revoked:       map[string]time.Time{},
+	}
+}
+
+func (m *memStore) SaveUser(u *user) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if u.Username != "" {
+		if other, ok := m.usersByName[u.Username]; ok && other.ID != u.ID {
+			return ErrUsernameTaken
+		}
+	}
+	if old := m.nameByID[u.ID]; old != "" && old != u.Username {
+		delete(m.usersByName, old)
+	}
+	m.index(u)
+	return nil
+}
+
+func (m *memStore) UserByID(id string) (*user, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.usersByID[id], nil
+}
+
+func (m *memStore) UserByName(username string) (*user, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.usersByName[username], nil
+}
+
+// cacheUser records u unless a value with the same ID is already cached, in
+// which case the cached value wins so every caller shares one *user.
+func (m *memStore) cacheUser(u *user) *user {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if cur, ok := m.usersByID[u.ID]; ok {
+		return cur
+	}
+	m.index(u)
+	return u
+}
+
+func (m *memStore) index(u *user) {
+	m.usersByID[u.ID] = u
+	m.nameByID[u.ID] = u.Username
+	if u.Username != "" {
+		m.usersByName[u.Username] = u
+	}
+}
+
+func (m *memStore) Enqueue(us ...*user) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.waitingQueue = append(m.waitingQueue, us...)
+}
+
+func (m *memStore) Dequeue(u *user) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	q := m.waitingQueue[:0]
+	for _, v := range m.waitingQueue {
+		if v != u {
+			q = append(q, v)
+		}
+	}
+	for i := len(q); i < len(m.waitingQueue); i++ {
+		m.waitingQueue[i] = nil
+	}
+	m.waitingQueue = q
+}
+
+func (m *memStore) Queue() []*user {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return append([]*user(nil), m.waitingQueue...)
+}
+
+func (m *memStore) SaveConversation(c *conversation) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.conversations[c.ID] = c
+	return nil
+}
+
+func (m *memStore) Conversation(id string) *conversation {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.conversations[id]
+}
+
+func (m *memStore) Conversations() []*conversation {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	out := make([]*conversation, 0, len(m.conversations))
+	for _, c := range m.conversations {
+		out = append(out, c)
+	}
+	return out
+}
+
+func (m *memStore) EndConversation(c *conversation) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.conversations, c.ID)
+	return nil
+}
+
+func (m *memStore) AddContact(a, b contact) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, c := range m.contacts[a.UserID] {
+		if c.UserID == b.UserID {
+			return nil
+		}
+	}
+	b.Since = a.Since
+	m.contacts[a.UserID] = append(m.contacts[a.UserID], b)
+	m.contacts[b.UserID] = append(m.contacts[b.UserID], a)
+	return nil
+}
+
+func (m *memStore) Contacts(userID string) ([]contact, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return append([]contact(nil), m.contacts[userID]...), nil
+}
+
+// Block keeps nothing: the live set is on the cached *user, and memory is
+// all there is.
+func (m *memStore) Block(blockerID, blockedID string, at time.Time) error { return nil }
+
+func (m *memStore) SaveReport(r report) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.reports = append(m.reports, r)
+	return nil
+}
+
+func (m *memStore) SaveRefreshToken(t *refreshToken) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	for h, v := range m.refreshTokens {
+		if now.After(v.ExpiresAt) {
+			delete(m.refreshTokens, h)
+		}
+	}
+	m.refreshTokens[t.Hash] = *t
+	return nil
+}
+
+func (m *memStore) RefreshToken(hash string) (*refreshToken, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	t, ok := m.refreshTokens[hash]
+	if !ok {
+		return nil, nil
+	}
+	return &t, nil
+}
+
+func (m *memStore) UseRefreshToken(hash string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ok := m.refreshTokens[hash]
+	if !ok || t.Used {
+		return false, nil
+	}
+	t.Used = true
+	m.refreshTokens[hash] = t
+	return true, nil
+}
+
+func (m *memStore) RevokeFamily(family string, until time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for h, v := range m.refreshTokens {
+		if v.Family == family {
+			delete(m.refreshTokens, h)
+		}
+	}
+	m.revoke("fam:"+family, until)
+	return nil
+}
+
+func (m *memStore) RevokeToken(jti string, until time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.revoke("jti:"+jti, until)
+	return nil
+}
+
+func (m *memStore) revoke(key string, until time.Time) {
+	now := time.Now()
+	for k, exp := range m.revoked {
+		if now.After(exp) {
+			delete(m.revoked, k)
+		}
+	}
+	if until.After(m.revoked[key]) {
+		m.revoked[key] = until
+	}
+}
+
+func (m *memStore) IsRevoked(jti, family string) (bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	now := time.Now()
+	for _, k := range []string{"jti:" + jti, "fam:" + family} {
+		if exp, ok := m.revoked[k]; ok && now.Before(exp) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *memStore) Close() error { return nil }
@@ -0,0 +1,115 @@
+// backend/ops/tokens.go
+// Session tokens: short‑lived JWT access tokens plus opaque, rotating refresh
+// tokens grouped into families. Reusing a refresh token revokes its family.
+
+package ops
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"time"
+)
+
+var (
+	errRefreshInvalid = errors.New("refresh token unknown or expired")
+	errRefreshReused  = errors.New("refresh token reused")
+	errTokenRevoked   = errors.New("token revoked")
+	errTokenNoID      = errors.New("token has no jti")
+	errTokenNoSubject = errors.New("token has no sub")
+)
+
+// refreshToken is the stored half of an opaque refresh token; only the hash
+// of the value handed to the client is kept.
+type refreshToken struct {
+	Hash      string
+	Family    string // shared by every rotation of one login/session
+	UserID    string
+	ExpiresAt time.Time
+	Used      bool
+}
+
+// session is what every login‑like endpoint hands back to the client.
+type session struct {
+	AccessToken  string
+	RefreshToken string
+	Family       string
+}
+
+func newOpaqueToken() string {
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func hashToken(tok string) string {
+	sum := sha256.Sum256([]byte(tok))
+	return hex.EncodeToString(sum[:])
+}
+
+// refreshTTLFor picks the family lifetime: anonymous sessions are shorter.
+func (s *Server) refreshTTLFor(u *user) time.Duration {
+	if s.usernameOf(u) == "" {
+		return s.cfg.Auth.AnonSessionTTL
+	}
+	return s.cfg.Auth.RegisteredTTL
+}
+
+// startSession opens a new refresh family for u.
+func (s *Server) startSession(u *user) (session, error) {
+	return s.rotateSession(u, genID())
+}
+
+// rotateSession issues a fresh access + refresh pair inside family.
+func (s *Server) rotateSession(u *user, family string) (session, error) {
+	raw := newOpaqueToken()
+	rt := &refreshToken{
+		Hash:      hashToken(raw),
+		Family:    family,
+		UserID:    u.ID,
+		ExpiresAt: time.Now().Add(s.refreshTTLFor(u)),
+	}
+	if err := s.store.SaveRefreshToken(rt); err != nil {
+		return session{}, err
+	}
+	access, err := s.issueJWT(u, family, s.cfg.Auth.AccessTokenTTL)
+	if err != nil {
+		return session{}, err
+	}
+	return session{AccessToken: access, RefreshToken: raw, Family: family}, nil
+}
+
+// refreshSession consumes raw and returns its successor. Presenting a token
+// that was already rotated is treated as theft: the whole family is revoked.
+func (s *Server) refreshSession(raw string) (*user, session, error) {
+	rt, err := s.store.RefreshToken(hashToken(raw))
+	if err != nil {
+		return nil, session{}, err
+	}
+	if rt == nil || time.Now().After(rt.ExpiresAt) {
+		return nil, session{}, errRefreshInvalid
+	}
+	fresh, err := s.store.UseRefreshToken(rt.Hash)
+	if err != nil {
+		return nil, session{}, err
+	}
+	if !fresh {
+		slog.Warn("Refresh token reuse detected; revoking family", "family", rt.Family, "userID", rt.UserID)
+		if err := s.store.RevokeFamily(rt.Family, time.Now().Add(s.cfg.Auth.AccessTokenTTL)); err != nil {
+			slog.Error("Failed to revoke token family", "family", rt.Family, "error", err)
+		}
+		return nil, session{}, errRefreshReused
+	}
+	u, err := s.store.UserByID(rt.UserID)
+	if err != nil {
+		return nil, session{}, err
+	}
+	if u == nil {
+		return nil, session{}, errRefreshInvalid
+	}
+	sess, err := s.rotateSession(u, rt.Family)
+	return u, sess, err
+}
@@ -1,18 +1,28 @@
 package server
 
 import (
+	"context"
 	"log/slog"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"backend/api"
+	"backend/config"
 	"backend/ops"
 )
 
-// Logger instance (can be set to Text or JSON based on environment)
-var logger = slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
-	Level: slog.LevelDebug, // Change to LevelInfo to reduce verbosity
-}))
+// newLogger builds the process logger from the log section of the config.
+func newLogger(cfg config.LogConfig) *slog.Logger {
+	var level slog.Level
+	_ = level.UnmarshalText([]byte(cfg.Level)) // validated by config.Load
+	opts := &slog.HandlerOptions{Level: level}
+	if cfg.Format == "json" {
+		return slog.New(slog.NewJSONHandler(os.Stdout, opts))
+	}
+	return slog.New(slog.NewTextHandler(os.Stdout, opts))
+}
 
 
 // CORS Middleware
@@ -24,7 +34,7 @@ func enableCORS(next http.Handler) http.Handler {
 
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusNoContent)
-			logger.Debug("CORS preflight", slog.String("path", r.URL.Path))
+			slog.Debug("CORS preflight", slog.String("path", r.URL.Path))
 			return
 		}
 
@@ -34,33 +44,68 @@ func enableCORS(next http.Handler) http.Handler {
 
 
 // StartServer initializes the HTTP + WebSocket server
-func StartServer() {
+func StartServer(cfg *config.Config) {
 	// ── 1. configure global logger ────────────────────────────────
-	h := slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
-		Level: slog.LevelDebug, // adjust via env/flag if desired
-	})
-	slog.SetDefault(slog.New(h))
+	slog.SetDefault(newLogger(cfg.Log))
+	slog.Info("configuration loaded", "env", cfg.Env, "store", cfg.Store.Driver,
+		"roundDuration", cfg.Chat.RoundDuration.String(), "logLevel", cfg.Log.Level)
 
-	// ── 2. build OpenAPI‑driven HTTP mux ───────────────────────────
-	impl := ops.New()
+	// ── 2. open the store ─────────────────────────────────────────
+	store := ops.NewMemoryStore()
+	if cfg.Store.Driver == "sqlite" {
+		var err error
+		if store, err = ops.OpenSQLite(cfg.Store.Path); err != nil {
+			slog.Error("failed to open database", "path", cfg.Store.Path, "err", err)
+			os.Exit(1)
+		}
+		slog.Info("using sqlite store", "path", cfg.Store.Path)
+	}
+	defer store.Close()
+
+	// ── 3. build OpenAPI‑driven HTTP mux ───────────────────────────
+	impl, err := ops.New(cfg, store)
+	if err != nil {
+		slog.Error("failed to initialise server", "err", err)
+		os.Exit(1)
+	}
 
 	openapiMux := api.HandlerWithOptions(
 		impl,
 		api.StdHTTPServerOptions{BaseURL: ""},
 	)
 
-	// ── 3. wrap with CORS (and other middlewares) ─────────────────
+	// ── 4. wrap with CORS (and other middlewares) ─────────────────
 	rootHandler := enableCORS(openapiMux)
 
-	// ── 4. serve ──────────────────────────────────────────────────
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = "3000"
-	}
-	addr := "0.0.0.0:" + port
-	slog.Info("server starting", "addr", addr)
+	// ── 5. serve until SIGINT / SIGTERM ───────────────────────────
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	httpServer := &http.Server{Addr: cfg.Server.Addr, Handler: rootHandler}
+	serveErr := make(chan error, 1)
+	go func() {
+		slog.Info("server starting", "addr", cfg.Server.Addr)
+		serveErr <- httpServer.ListenAndServe()
+	}()
 
-	if err := http.ListenAndServe(addr, rootHandler); err != nil {
+	select {
+	case err := <-serveErr:
 		slog.Error("server failed", "err", err)
+		return
+	case <-ctx.Done():
+		stop() // a second signal kills the process immediately
+	}
+
+	// ── 6. drain: chats first (hijacked sockets are invisible to
+	//       http.Server), then the listener and in‑flight requests ─
+	slog.Info("shutdown signal received", "timeout", cfg.Server.ShutdownTimeout.String())
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+	defer cancel()
+	if err := impl.Shutdown(shutdownCtx); err != nil {
+		slog.Warn("chat drain incomplete", "err", err)
+	}
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		slog.Warn("http shutdown incomplete", "err", err)
 	}
-}
\ No newline at end of file
+	slog.Info("server stopped")
+}